@@ -69,6 +69,9 @@ const (
 
 	flagLogIMAP = "log-imap"
 	flagLogSMTP = "log-smtp"
+
+	flagRESTAPI     = "rest-api"
+	flagRESTAPIPort = "rest-api-port"
 )
 
 // Hidden flags.
@@ -129,6 +132,14 @@ func New() *cli.App {
 			Name:  flagLogSMTP,
 			Usage: "Enable logging of SMTP communications (may contain decrypted data!)",
 		},
+		&cli.BoolFlag{
+			Name:  flagRESTAPI,
+			Usage: "Start the local REST API alongside the frontend",
+		},
+		&cli.IntFlag{
+			Name:  flagRESTAPIPort,
+			Usage: "Port of the local REST API (0 lets the system choose; the port is written to restServerConfig.json)",
+		},
 
 		// Hidden flags
 		&cli.BoolFlag{
@@ -159,6 +170,7 @@ func New() *cli.App {
 		},
 	}
 
+	app.Commands = newCommands()
 	app.Action = run
 
 	return app
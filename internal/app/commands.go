@@ -0,0 +1,620 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/allan-simon/go-singleinstance"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Exit codes returned by the one-shot commands.
+const (
+	exitCodeError          = 1
+	exitCodeUsage          = 2
+	exitCodeAlreadyRunning = 3
+	exitCodeNotFound       = 4
+	exitCodeAuth           = 5
+)
+
+const (
+	flagJSON = "json"
+
+	// usersLoadTimeout is how long commands wait for the users to be loaded from the vault.
+	usersLoadTimeout = time.Minute
+)
+
+var (
+	errAlreadyRunning  = errors.New("another instance is already running, stop it before running this command")
+	errInvalidArgument = errors.New("invalid argument")
+	errNoSuchAccount   = errors.New("no such account")
+)
+
+// newCommands returns the one-shot commands, which run a single operation against the bridge and exit.
+func newCommands() []*cli.Command {
+	jsonFlag := &cli.BoolFlag{
+		Name:  flagJSON,
+		Usage: "Print the output as JSON",
+	}
+
+	adminResetFlag := &cli.BoolFlag{
+		Name:  flagAdminReset,
+		Usage: "Reset the vault if it can't be read, losing the accounts and settings it holds, rather than refusing to change it",
+	}
+
+	certsStoreFlags := []cli.Flag{
+		&cli.BoolFlag{
+			Name:  flagCertsNSS,
+			Usage: "Update the user's NSS databases, used by Thunderbird, Evolution, Firefox and Chromium (--nss=false to skip)",
+			Value: true,
+		},
+		&cli.BoolFlag{
+			Name:  flagCertsSystem,
+			Usage: "Update the system's trust anchors, which requires root",
+		},
+		jsonFlag,
+	}
+
+	return []*cli.Command{
+		{
+			Name:  "accounts",
+			Usage: "Manage the accounts",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the accounts",
+					Flags:  []cli.Flag{jsonFlag},
+					Action: withCommand(listAccounts),
+				},
+				{
+					Name:      "info",
+					Usage:     "Show the configuration of an account",
+					ArgsUsage: "<user ID, username or address>",
+					Flags:     []cli.Flag{jsonFlag},
+					Action:    withCommand(showAccountInfo),
+				},
+				{
+					Name:      "logout",
+					Usage:     "Log out an account",
+					ArgsUsage: "<user ID, username or address>",
+					Action:    withCommand(logoutAccount),
+				},
+				{
+					Name:      "address-mode",
+					Usage:     "Change the address mode of an account (combined or split)",
+					ArgsUsage: "<user ID, username or address> <mode>",
+					Action:    withCommand(setAccountAddressMode),
+				},
+				{
+					Name:      "delete",
+					Usage:     "Remove an account and its data",
+					ArgsUsage: "<user ID, username or address>",
+					Action:    withCommand(deleteAccount),
+				},
+				{
+					Name:  "app-passwords",
+					Usage: "Manage the app passwords, which can be used instead of the bridge password, e.g. one per device",
+					Subcommands: []*cli.Command{
+						{
+							Name:      "list",
+							Usage:     "List the app passwords of an account",
+							ArgsUsage: "<user ID, username or address>",
+							Flags:     []cli.Flag{jsonFlag},
+							Action:    withCommand(listAppPasswords),
+						},
+						{
+							Name:      "create",
+							Usage:     "Create an app password and print it; it can't be shown again",
+							ArgsUsage: "<user ID, username or address> <name>",
+							Flags: []cli.Flag{
+								&cli.StringFlag{
+									Name:  flagAppPasswordIMAP,
+									Usage: "The access granted over IMAP (read-write, read-only or none)",
+									Value: vault.IMAPReadWrite.String(),
+								},
+								&cli.StringFlag{
+									Name:  flagAppPasswordSMTP,
+									Usage: "The access granted over SMTP (send or none)",
+									Value: vault.SMTPSend.String(),
+								},
+								&cli.StringSliceFlag{
+									Name:  flagAppPasswordAddress,
+									Usage: "Restrict the app password to the given address, in split mode (can be repeated)",
+								},
+								jsonFlag,
+							},
+							Action: withCommand(createAppPassword),
+						},
+						{
+							Name:      "revoke",
+							Usage:     "Revoke an app password so that it can no longer be used",
+							ArgsUsage: "<user ID, username or address> <app password ID or name>",
+							Action:    withCommand(revokeAppPassword),
+						},
+					},
+				},
+				{
+					Name:  "client-certs",
+					Usage: "Manage the TLS client certificates, which can be required along with passwords or used instead of them",
+					Subcommands: []*cli.Command{
+						{
+							Name:      "list",
+							Usage:     "List the client certificates of an account",
+							ArgsUsage: "<user ID, username or address>",
+							Flags:     []cli.Flag{jsonFlag},
+							Action:    withCommand(listClientCerts),
+						},
+						{
+							Name:      "enroll",
+							Usage:     "Issue a client certificate signed by the client CA, save it as PKCS#12 and print its password",
+							ArgsUsage: "<user ID, username or address> <name>",
+							Flags: []cli.Flag{
+								&cli.StringFlag{
+									Name:     flagClientCertOutput,
+									Usage:    "Save the certificate and its private key to the given PKCS#12 file, which must not exist",
+									Required: true,
+								},
+								&cli.BoolFlag{
+									Name:  flagClientCertSkipPassword,
+									Usage: "Allow authenticating over SMTP with the certificate alone (SASL EXTERNAL)",
+								},
+								jsonFlag,
+							},
+							Action: withCommand(enrollClientCert),
+						},
+						{
+							Name:      "add",
+							Usage:     "Map an existing client certificate to an account",
+							ArgsUsage: "<user ID, username or address> <name>",
+							Flags: []cli.Flag{
+								&cli.StringFlag{
+									Name:  flagClientCertFingerprint,
+									Usage: "Match the certificate by its SHA-256 fingerprint, in hex, whoever issued it",
+								},
+								&cli.StringFlag{
+									Name:  flagClientCertSubject,
+									Usage: "Match the certificate by its subject, e.g. 'CN=laptop,O=Example', if issued by the client CA",
+								},
+								&cli.BoolFlag{
+									Name:  flagClientCertSkipPassword,
+									Usage: "Allow authenticating over SMTP with the certificate alone (SASL EXTERNAL)",
+								},
+								jsonFlag,
+							},
+							Action: withCommand(addClientCert),
+						},
+						{
+							Name:      "remove",
+							Usage:     "Remove a client certificate so that it can no longer be used",
+							ArgsUsage: "<user ID, username or address> <client certificate ID or name>",
+							Action:    withCommand(removeClientCert),
+						},
+						{
+							Name:      "export-ca",
+							Usage:     "Export the client CA as cert.pem and key.pem, to issue certificates matched by subject",
+							ArgsUsage: "<directory>",
+							Action:    withCommand(exportClientCA),
+						},
+					},
+				},
+				{
+					Name:  "outbox",
+					Usage: "Manage the messages waiting to be sent because Proton could not be reached",
+					Subcommands: []*cli.Command{
+						{
+							Name:      "list",
+							Usage:     "List the messages waiting to be sent",
+							ArgsUsage: "<user ID, username or address>",
+							Flags:     []cli.Flag{jsonFlag},
+							Action:    withCommand(listOutbox),
+						},
+						{
+							Name:      "cancel",
+							Usage:     "Cancel sending a message",
+							ArgsUsage: "<user ID, username or address> <message ID>",
+							Action:    withCommand(cancelOutboxMessage),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "login",
+			Usage: "Log in an account",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     flagLoginUser,
+					Usage:    "The username or address of the account",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     flagLoginPasswordFile,
+					Usage:    "Read the password from the given file (- for standard input)",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  flagLoginMailboxPasswordFile,
+					Usage: "Read the mailbox password from the given file, for accounts in two-password mode",
+				},
+				&cli.StringFlag{
+					Name:  flagLoginTOTP,
+					Usage: "The two-factor authentication code, for accounts with 2FA enabled",
+				},
+				jsonFlag,
+			},
+			Action: withCommand(loginAccount),
+		},
+		{
+			Name:  "certs",
+			Usage: "Manage the trust of the bridge TLS certificate by mail clients",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "install",
+					Usage:  "Install the bridge certificate into the trust stores on Linux and print those which were updated",
+					Flags:  certsStoreFlags,
+					Action: withCommand(installCerts),
+				},
+				{
+					Name:   "uninstall",
+					Usage:  "Uninstall the bridge certificate from the trust stores on Linux and print those which were updated",
+					Flags:  certsStoreFlags,
+					Action: withCommand(uninstallCerts),
+				},
+			},
+		},
+		{
+			Name:  "vault",
+			Usage: "Manage the vault, which holds the accounts and settings",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "restore",
+					Usage:     "List the generations of the vault kept as backups, or restore one of them",
+					ArgsUsage: "[generation]",
+					Flags:     []cli.Flag{jsonFlag},
+					Action:    withCommandVault(restoreVault),
+				},
+				{
+					Name:   "rekey",
+					Usage:  "Re-encrypt the vault and the cached messages with new keys, replacing the vault key in the keychain",
+					Flags:  []cli.Flag{jsonFlag},
+					Action: withCommand(rekeyVault),
+				},
+			},
+		},
+		{
+			Name:  "admin",
+			Usage: "Inspect and repair the vault while Bridge is stopped, without connecting to Proton",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "info",
+					Usage:  "Show the location and version of the vault, and whether it could be read",
+					Flags:  []cli.Flag{jsonFlag},
+					Action: withCommandAdmin(showVaultInfo),
+				},
+				{
+					Name:  "users",
+					Usage: "Manage the accounts stored in the vault",
+					Subcommands: []*cli.Command{
+						{
+							Name:   "list",
+							Usage:  "List the accounts and their sync status",
+							Flags:  []cli.Flag{jsonFlag},
+							Action: withCommandAdmin(listVaultUsers),
+						},
+						{
+							Name:      "remove",
+							Usage:     "Remove an account from the vault, along with its cached messages",
+							ArgsUsage: "<user ID, username or address>",
+							Flags:     []cli.Flag{adminResetFlag},
+							Action:    withCommandAdminWrite(removeVaultUser),
+						},
+						{
+							Name:      "clear-sync",
+							Usage:     "Clear the sync status and the event ID of an account, so that it is fully synced again",
+							ArgsUsage: "<user ID, username or address>",
+							Flags:     []cli.Flag{adminResetFlag},
+							Action:    withCommandAdminWrite(clearVaultUserSync),
+						},
+						{
+							Name:      "clear-failed",
+							Usage:     "Clear the messages of an account which failed to be synced",
+							ArgsUsage: "<user ID, username or address>",
+							Flags:     []cli.Flag{adminResetFlag},
+							Action:    withCommandAdminWrite(clearVaultUserFailed),
+						},
+						{
+							Name:      "reset-event-id",
+							Usage:     "Set the event from which the events of an account are processed",
+							ArgsUsage: "<user ID, username or address> <event ID>",
+							Flags:     []cli.Flag{adminResetFlag},
+							Action:    withCommandAdminWrite(resetVaultUserEventID),
+						},
+					},
+				},
+				{
+					Name:  "settings",
+					Usage: "Manage the settings stored in the vault",
+					Subcommands: []*cli.Command{
+						{
+							Name:      "get",
+							Usage:     "Show the value of one or all settings",
+							ArgsUsage: "[setting]",
+							Flags:     []cli.Flag{jsonFlag},
+							Action:    withCommandAdmin(getVaultSettings),
+						},
+						{
+							Name:      "set",
+							Usage:     "Change the value of a setting",
+							ArgsUsage: "<setting> <value>",
+							Flags:     []cli.Flag{adminResetFlag},
+							Action:    withCommandAdminWrite(setVaultSetting),
+						},
+					},
+				},
+				{
+					Name:  "export",
+					Usage: "Print the vault as JSON, without its keys, passwords, tokens and cookies",
+					Flags: []cli.Flag{
+						&cli.BoolFlag{
+							Name:  flagAdminShowSecrets,
+							Usage: "Also print the secrets",
+						},
+					},
+					Action: withCommandAdmin(exportVault),
+				},
+			},
+		},
+		{
+			Name:  "settings",
+			Usage: "Manage the settings",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "get",
+					Usage:     "Show the value of one or all settings",
+					ArgsUsage: "[setting]",
+					Flags:     []cli.Flag{jsonFlag},
+					Action:    withCommand(getSettings),
+				},
+				{
+					Name:      "set",
+					Usage:     "Change the value of a setting",
+					ArgsUsage: "<setting> <value>",
+					Action:    withCommand(setSetting),
+				},
+			},
+		},
+	}
+}
+
+// withCommand returns an action which runs the given command against a fully initialized bridge.
+// Errors are converted to errors carrying an exit code, so the app exits with a meaningful status.
+func withCommand(fn func(*cli.Context, *bridge.Bridge, <-chan events.Event) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		return toExitError(withCommandBridge(c, func(b *bridge.Bridge, eventCh <-chan events.Event) error {
+			return fn(c, b, eventCh)
+		}))
+	}
+}
+
+// withCommandBridge sets up the bridge for a one-shot command.
+// Unlike the regular run, it never restarts the app, migrates old data or raises an already running instance.
+func withCommandBridge(c *cli.Context, fn func(*bridge.Bridge, <-chan events.Event) error) error {
+	version, err := semver.NewVersion(constants.Version)
+	if err != nil {
+		return fmt.Errorf("could not create version: %w", err)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		exe = os.Args[0]
+	}
+
+	identifier := useragent.New()
+
+	reporter := sentry.NewReporter(constants.FullAppName, identifier)
+
+	crashHandler := crash.NewHandler(reporter.ReportException)
+	defer async.HandlePanic(crashHandler)
+
+	return withCommandLocations(c, crashHandler, func(locations *locations.Locations) error {
+		return WithVault(locations, crashHandler, func(v *vault.Vault, insecure, corrupt bool) error {
+			if insecure {
+				logrus.Warn("The vault key could not be retrieved; the vault will not be encrypted")
+			}
+
+			if corrupt {
+				logrus.Warn("The vault is corrupt and has been wiped")
+			}
+
+			return withCookieJar(v, func(cookieJar http.CookieJar) error {
+				return withBridge(c, exe, locations, version, identifier, crashHandler, reporter, v, cookieJar, fn)
+			})
+		})
+	})
+}
+
+// withCommandVault returns an action which runs the given command against the vault files, given their directory and key.
+// The vault isn't opened, so that the command can e.g. replace it.
+func withCommandVault(fn func(c *cli.Context, vaultDir string, vaultKey []byte) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		reporter := sentry.NewReporter(constants.FullAppName, useragent.New())
+
+		crashHandler := crash.NewHandler(reporter.ReportException)
+		defer async.HandlePanic(crashHandler)
+
+		return toExitError(withCommandLocations(c, crashHandler, func(locations *locations.Locations) error {
+			vaultDir, vaultKey, _, err := getVaultDirAndKey(locations, true)
+			if err != nil {
+				return err
+			}
+
+			return fn(c, vaultDir, vaultKey)
+		}))
+	}
+}
+
+// withCommandLocations sets up the locations and logging for a one-shot command,
+// and makes sure no other instance is running while it runs.
+func withCommandLocations(c *cli.Context, crashHandler *crash.Handler, fn func(*locations.Locations) error) error {
+	var logCloser io.Closer
+	defer func() {
+		_ = logging.Close(logCloser)
+	}()
+
+	return WithLocations(func(locations *locations.Locations) error {
+		return withLogging(c, crashHandler, locations, func(closer io.Closer) error {
+			logCloser = closer
+
+			lock, err := singleinstance.CreateLockFile(locations.GetLockFile())
+			if err != nil {
+				return errAlreadyRunning
+			}
+
+			defer func() {
+				if err := lock.Close(); err != nil {
+					logrus.WithError(err).Error("Failed to close lock file")
+				}
+			}()
+
+			return fn(locations)
+		})
+	})
+}
+
+// waitForUsersLoaded blocks until the bridge has loaded its users from the vault.
+func waitForUsersLoaded(ctx context.Context, eventCh <-chan events.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, usersLoadTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("users were not loaded: %w", ctx.Err())
+
+		case event := <-eventCh:
+			if _, ok := event.(events.AllUsersLoaded); ok {
+				return nil
+			}
+		}
+	}
+}
+
+// findAccount returns the account matching the given user ID, username or address.
+func findAccount(b *bridge.Bridge, query string) (bridge.UserInfo, error) {
+	for _, userID := range b.GetUserIDs() {
+		user, err := b.GetUserInfo(userID)
+		if err != nil {
+			return bridge.UserInfo{}, err
+		}
+
+		if user.UserID == query || user.Username == query {
+			return user, nil
+		}
+
+		for _, address := range user.Addresses {
+			if address == query {
+				return user, nil
+			}
+		}
+	}
+
+	return bridge.UserInfo{}, fmt.Errorf("%w: %v", errNoSuchAccount, query)
+}
+
+// getAccountArg returns the account given as the single argument of the command.
+func getAccountArg(c *cli.Context, b *bridge.Bridge) (bridge.UserInfo, error) {
+	if c.NArg() != 1 {
+		return bridge.UserInfo{}, fmt.Errorf("%w: expected exactly one account", errInvalidArgument)
+	}
+
+	return findAccount(b, c.Args().First())
+}
+
+// printJSON writes the given value as indented JSON to the command's output.
+func printJSON(c *cli.Context, v any) error {
+	enc := json.NewEncoder(c.App.Writer)
+
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// toExitError converts an error returned by a command to an error carrying the matching exit code.
+func toExitError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code := exitCodeError
+
+	switch {
+	case errors.Is(err, errInvalidArgument):
+		code = exitCodeUsage
+
+	case errors.Is(err, errAlreadyRunning):
+		code = exitCodeAlreadyRunning
+
+	case errors.Is(err, errNoSuchAccount), errors.Is(err, bridge.ErrNoSuchUser), errors.Is(err, vault.ErrNoSuchOutboxMessage), errors.Is(err, vault.ErrNoSuchAppPassword),
+		errors.Is(err, vault.ErrNoSuchClientCert), errors.Is(err, vault.ErrNoSuchGeneration):
+		code = exitCodeNotFound
+
+	case errors.Is(err, errMissingTOTP), errors.Is(err, errMissingMailboxPassword), isAuthError(err):
+		code = exitCodeAuth
+	}
+
+	return cli.Exit(err, code)
+}
+
+// isAuthError returns whether the error was returned by the API because the credentials given were rejected.
+// Other API errors, such as those returned when the API is unavailable, are not authentication failures.
+func isAuthError(err error) bool {
+	apiErr := new(proton.APIError)
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	// nolint:exhaustive
+	switch apiErr.Code {
+	case proton.PasswordWrong, proton.UsernameInvalid, proton.AuthRefreshTokenInvalid:
+		return true
+
+	default:
+		return apiErr.Status == http.StatusUnauthorized || apiErr.Status == http.StatusUnprocessableEntity
+	}
+}
@@ -0,0 +1,594 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	flagLoginUser                = "user"
+	flagLoginPasswordFile        = "password-file"
+	flagLoginMailboxPasswordFile = "mailbox-password-file"
+	flagLoginTOTP                = "2fa-code"
+
+	flagAppPasswordIMAP    = "imap"
+	flagAppPasswordSMTP    = "smtp"
+	flagAppPasswordAddress = "address"
+)
+
+var (
+	errMissingTOTP            = errors.New("the account requires a two-factor authentication code")
+	errMissingMailboxPassword = errors.New("the account requires a mailbox password")
+)
+
+type accountJSON struct {
+	UserID      string   `json:"userID"`
+	Username    string   `json:"username"`
+	State       string   `json:"state"`
+	Addresses   []string `json:"addresses"`
+	AddressMode string   `json:"addressMode"`
+	UsedSpace   int      `json:"usedSpace"`
+	MaxSpace    int      `json:"maxSpace"`
+}
+
+type outboxMessageJSON struct {
+	ID        string    `json:"id"`
+	From      string    `json:"from"`
+	To        []string  `json:"to"`
+	Subject   string    `json:"subject"`
+	Size      int       `json:"size"`
+	QueuedAt  time.Time `json:"queuedAt"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"lastError"`
+}
+
+type appPasswordJSON struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	IMAP       string     `json:"imap"`
+	SMTP       string     `json:"smtp"`
+	Addresses  []string   `json:"addresses"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+type createdAppPasswordJSON struct {
+	appPasswordJSON
+
+	Password string `json:"password"`
+}
+
+type accountInfoJSON struct {
+	accountJSON
+
+	Hostname string `json:"hostname"`
+	Password string `json:"password"`
+	IMAPPort int    `json:"imapPort"`
+	IMAPSSL  bool   `json:"imapSSL"`
+	SMTPPort int    `json:"smtpPort"`
+	SMTPSSL  bool   `json:"smtpSSL"`
+
+	IMAPSocket string `json:"imapSocket,omitempty"`
+	SMTPSocket string `json:"smtpSocket,omitempty"`
+}
+
+func newAccountJSON(user bridge.UserInfo) accountJSON {
+	addresses := user.Addresses
+	if addresses == nil {
+		addresses = []string{}
+	}
+
+	return accountJSON{
+		UserID:      user.UserID,
+		Username:    user.Username,
+		State:       userStateToString(user.State),
+		Addresses:   addresses,
+		AddressMode: user.AddressMode.String(),
+		UsedSpace:   user.UsedSpace,
+		MaxSpace:    user.MaxSpace,
+	}
+}
+
+func newAppPasswordJSON(appPass bridge.AppPasswordInfo) appPasswordJSON {
+	var lastUsedAt *time.Time
+
+	if !appPass.LastUsedAt.IsZero() {
+		lastUsedAt = &appPass.LastUsedAt
+	}
+
+	addresses := appPass.Scope.Addresses
+	if addresses == nil {
+		addresses = []string{}
+	}
+
+	return appPasswordJSON{
+		ID:         appPass.ID,
+		Name:       appPass.Name,
+		IMAP:       appPass.Scope.IMAP.String(),
+		SMTP:       appPass.Scope.SMTP.String(),
+		Addresses:  addresses,
+		CreatedAt:  appPass.CreatedAt,
+		LastUsedAt: lastUsedAt,
+	}
+}
+
+func userStateToString(state bridge.UserState) string {
+	switch state {
+	case bridge.SignedOut:
+		return "signed-out"
+	case bridge.Locked:
+		return "locked"
+	case bridge.Connected:
+		return "connected"
+	default:
+		return "unknown"
+	}
+}
+
+func listAccounts(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	accounts := make([]accountJSON, 0, len(b.GetUserIDs()))
+
+	for _, userID := range b.GetUserIDs() {
+		user, err := b.GetUserInfo(userID)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, newAccountJSON(user))
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, accounts)
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "USER ID\tUSERNAME\tSTATE\tADDRESS MODE")
+
+	for _, account := range accounts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", account.UserID, account.Username, account.State, account.AddressMode)
+	}
+
+	return w.Flush()
+}
+
+func showAccountInfo(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	user, err := getAccountArg(c, b)
+	if err != nil {
+		return err
+	}
+
+	info := accountInfoJSON{
+		accountJSON: newAccountJSON(user),
+		Hostname:    constants.Host,
+		Password:    string(user.BridgePass),
+		IMAPPort:    b.GetIMAPPort(),
+		IMAPSSL:     b.GetIMAPSSL(),
+		SMTPPort:    b.GetSMTPPort(),
+		SMTPSSL:     b.GetSMTPSSL(),
+	}
+
+	if info.IMAPSocket, err = b.GetIMAPSocketPath(); err != nil {
+		return err
+	}
+
+	if info.SMTPSocket, err = b.GetSMTPSocketPath(); err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, info)
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "User ID:\t%s\n", info.UserID)
+	fmt.Fprintf(w, "Username:\t%s\n", info.Username)
+	fmt.Fprintf(w, "State:\t%s\n", info.State)
+	fmt.Fprintf(w, "Addresses:\t%s\n", strings.Join(info.Addresses, ", "))
+	fmt.Fprintf(w, "Address mode:\t%s\n", info.AddressMode)
+	fmt.Fprintf(w, "Hostname:\t%s\n", info.Hostname)
+	fmt.Fprintf(w, "Password:\t%s\n", info.Password)
+	fmt.Fprintf(w, "IMAP port:\t%d\n", info.IMAPPort)
+	fmt.Fprintf(w, "IMAP SSL:\t%t\n", info.IMAPSSL)
+	fmt.Fprintf(w, "SMTP port:\t%d\n", info.SMTPPort)
+	fmt.Fprintf(w, "SMTP SSL:\t%t\n", info.SMTPSSL)
+
+	if info.IMAPSocket != "" {
+		fmt.Fprintf(w, "IMAP socket:\t%s\n", info.IMAPSocket)
+	}
+
+	if info.SMTPSocket != "" {
+		fmt.Fprintf(w, "SMTP socket:\t%s\n", info.SMTPSocket)
+	}
+
+	return w.Flush()
+}
+
+func logoutAccount(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	user, err := getAccountArg(c, b)
+	if err != nil {
+		return err
+	}
+
+	if user.State != bridge.Connected {
+		return nil
+	}
+
+	return b.LogoutUser(c.Context, user.UserID)
+}
+
+func setAccountAddressMode(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and an address mode", errInvalidArgument)
+	}
+
+	mode, err := addressModeFromString(c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	if user.AddressMode == mode {
+		return nil
+	}
+
+	return b.SetAddressMode(c.Context, user.UserID, mode)
+}
+
+func deleteAccount(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	user, err := getAccountArg(c, b)
+	if err != nil {
+		return err
+	}
+
+	return b.DeleteUser(c.Context, user.UserID)
+}
+
+func listOutbox(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	user, err := getAccountArg(c, b)
+	if err != nil {
+		return err
+	}
+
+	outbox, err := b.GetOutbox(user.UserID)
+	if err != nil {
+		return err
+	}
+
+	messages := make([]outboxMessageJSON, 0, len(outbox))
+
+	for _, message := range outbox {
+		messages = append(messages, outboxMessageJSON(message))
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, messages)
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tQUEUED AT\tTO\tATTEMPTS\tSUBJECT")
+
+	for _, message := range messages {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+			message.ID,
+			message.QueuedAt.Format(time.RFC3339),
+			strings.Join(message.To, ", "),
+			message.Attempts,
+			message.Subject,
+		)
+	}
+
+	return w.Flush()
+}
+
+func cancelOutboxMessage(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and a message ID", errInvalidArgument)
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	return b.CancelOutboxMessage(user.UserID, c.Args().Get(1))
+}
+
+func listAppPasswords(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	user, err := getAccountArg(c, b)
+	if err != nil {
+		return err
+	}
+
+	appPasswords, err := b.GetAppPasswords(user.UserID)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, xslices.Map(appPasswords, newAppPasswordJSON))
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tIMAP\tSMTP\tADDRESSES\tCREATED AT\tLAST USED AT")
+
+	for _, appPass := range appPasswords {
+		addresses, lastUsedAt := "all", "never"
+
+		if len(appPass.Scope.Addresses) > 0 {
+			addresses = strings.Join(appPass.Scope.Addresses, ", ")
+		}
+
+		if !appPass.LastUsedAt.IsZero() {
+			lastUsedAt = appPass.LastUsedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			appPass.ID,
+			appPass.Name,
+			appPass.Scope.IMAP,
+			appPass.Scope.SMTP,
+			addresses,
+			appPass.CreatedAt.Format(time.RFC3339),
+			lastUsedAt,
+		)
+	}
+
+	return w.Flush()
+}
+
+func createAppPassword(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and a name", errInvalidArgument)
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	scope, err := appPasswordScopeFromFlags(c, user)
+	if err != nil {
+		return err
+	}
+
+	appPass, password, err := b.CreateAppPassword(user.UserID, c.Args().Get(1), scope)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, createdAppPasswordJSON{appPasswordJSON: newAppPasswordJSON(appPass), Password: string(password)})
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, string(password))
+
+	return err
+}
+
+func revokeAppPassword(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and an app password", errInvalidArgument)
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	appPasswords, err := b.GetAppPasswords(user.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, appPass := range appPasswords {
+		if appPass.ID == c.Args().Get(1) || appPass.Name == c.Args().Get(1) {
+			return b.RevokeAppPassword(user.UserID, appPass.ID)
+		}
+	}
+
+	return fmt.Errorf("%w: %v", vault.ErrNoSuchAppPassword, c.Args().Get(1))
+}
+
+func loginAccount(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	password, err := readPasswordFile(c.String(flagLoginPasswordFile))
+	if err != nil {
+		return fmt.Errorf("could not read password: %w", err)
+	}
+
+	userID, err := b.LoginFull(c.Context, c.String(flagLoginUser), password,
+		func() (string, error) {
+			if code := c.String(flagLoginTOTP); code != "" {
+				return code, nil
+			}
+
+			return "", errMissingTOTP
+		},
+		func() ([]byte, error) {
+			if path := c.String(flagLoginMailboxPasswordFile); path != "" {
+				return readPasswordFile(path)
+			}
+
+			return nil, errMissingMailboxPassword
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	user, err := b.GetUserInfo(userID)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, newAccountJSON(user))
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, user.UserID)
+
+	return err
+}
+
+// readPasswordFile reads a password from the given file, or from standard input if the path is "-".
+// A single trailing newline is stripped, as most tools which write such files add one.
+func readPasswordFile(path string) ([]byte, error) {
+	var (
+		b   []byte
+		err error
+	)
+
+	if path == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path) //nolint:gosec
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	b = bytes.TrimSuffix(bytes.TrimSuffix(b, []byte("\n")), []byte("\r"))
+
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%w: the password is empty", errInvalidArgument)
+	}
+
+	return b, nil
+}
+
+// appPasswordScopeFromFlags returns the scope of a new app password of the given account, as given by the flags.
+func appPasswordScopeFromFlags(c *cli.Context, user bridge.UserInfo) (vault.AppPasswordScope, error) {
+	var scope vault.AppPasswordScope
+
+	switch imap := c.String(flagAppPasswordIMAP); imap {
+	case vault.IMAPReadWrite.String():
+		scope.IMAP = vault.IMAPReadWrite
+
+	case vault.IMAPReadOnly.String():
+		scope.IMAP = vault.IMAPReadOnly
+
+	case vault.IMAPDenied.String():
+		scope.IMAP = vault.IMAPDenied
+
+	default:
+		return vault.AppPasswordScope{}, fmt.Errorf("%w: unknown IMAP access %q", errInvalidArgument, imap)
+	}
+
+	switch smtp := c.String(flagAppPasswordSMTP); smtp {
+	case vault.SMTPSend.String():
+		scope.SMTP = vault.SMTPSend
+
+	case vault.SMTPDenied.String():
+		scope.SMTP = vault.SMTPDenied
+
+	default:
+		return vault.AppPasswordScope{}, fmt.Errorf("%w: unknown SMTP access %q", errInvalidArgument, smtp)
+	}
+
+	for _, address := range c.StringSlice(flagAppPasswordAddress) {
+		if !slices.ContainsFunc(user.Addresses, func(other string) bool { return strings.EqualFold(other, address) }) {
+			return vault.AppPasswordScope{}, fmt.Errorf("%w: %v is not an address of the account", errInvalidArgument, address)
+		}
+
+		scope.Addresses = append(scope.Addresses, address)
+	}
+
+	return scope, nil
+}
+
+// addressModeFromString parses an address mode as printed by vault.AddressMode.String.
+func addressModeFromString(mode string) (vault.AddressMode, error) {
+	switch mode {
+	case vault.CombinedMode.String():
+		return vault.CombinedMode, nil
+
+	case vault.SplitMode.String():
+		return vault.SplitMode, nil
+
+	default:
+		return 0, fmt.Errorf("%w: unknown address mode %q", errInvalidArgument, mode)
+	}
+}
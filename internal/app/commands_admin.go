@@ -0,0 +1,561 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	flagAdminShowSecrets = "show-secrets"
+	flagAdminReset       = "reset"
+)
+
+var errVaultCorrupt = errors.New("the vault can't be read and would be reset; pass --" + flagAdminReset + " to reset it")
+
+type vaultInfoJSON struct {
+	Path           string `json:"path"`
+	Version        string `json:"version"`
+	CurrentVersion string `json:"currentVersion"`
+	Insecure       bool   `json:"insecure"`
+	Corrupt        bool   `json:"corrupt"`
+	UserCount      int    `json:"userCount"`
+}
+
+type vaultUserJSON struct {
+	UserID       string         `json:"userID"`
+	Username     string         `json:"username"`
+	PrimaryEmail string         `json:"primaryEmail"`
+	AddressMode  string         `json:"addressMode"`
+	LoggedIn     bool           `json:"loggedIn"`
+	EventID      string         `json:"eventID"`
+	SyncStatus   syncStatusJSON `json:"syncStatus"`
+}
+
+type syncStatusJSON struct {
+	HasLabels        bool     `json:"hasLabels"`
+	HasMessages      bool     `json:"hasMessages"`
+	LastMessageID    string   `json:"lastMessageID"`
+	FailedMessageIDs []string `json:"failedMessageIDs"`
+}
+
+func newVaultUserJSON(user *vault.User) vaultUserJSON {
+	status := user.SyncStatus()
+
+	failedMessageIDs := status.FailedMessageIDs
+	if failedMessageIDs == nil {
+		failedMessageIDs = []string{}
+	}
+
+	return vaultUserJSON{
+		UserID:       user.UserID(),
+		Username:     user.Username(),
+		PrimaryEmail: user.PrimaryEmail(),
+		AddressMode:  user.AddressMode().String(),
+		LoggedIn:     user.AuthUID() != "",
+		EventID:      user.EventID(),
+		SyncStatus: syncStatusJSON{
+			HasLabels:        status.HasLabels,
+			HasMessages:      status.HasMessages,
+			LastMessageID:    status.LastMessageID,
+			FailedMessageIDs: failedMessageIDs,
+		},
+	}
+}
+
+// vaultSetting describes a setting which can be read and changed in the vault by the admin commands.
+type vaultSetting struct {
+	get func(*vault.Vault) any
+	set func(*vault.Vault, string) error
+}
+
+// vaultSettings holds the settings which can be changed by the admin commands, keyed by name as in the settings commands.
+// Those which must be applied by a running bridge, e.g. because files are regenerated or moved, aren't included.
+var vaultSettings = map[string]vaultSetting{ //nolint:gochecknoglobals
+	"imap-port": {
+		get: func(v *vault.Vault) any { return v.GetIMAPPort() },
+		set: func(v *vault.Vault, value string) error {
+			port, err := parsePort(value, v.GetIMAPPort())
+			if err != nil {
+				return err
+			}
+
+			if port == v.GetSMTPPort() {
+				return fmt.Errorf("%w: port %v is used by the SMTP server", errInvalidArgument, port)
+			}
+
+			return v.SetIMAPPort(port)
+		},
+	},
+	"smtp-port": {
+		get: func(v *vault.Vault) any { return v.GetSMTPPort() },
+		set: func(v *vault.Vault, value string) error {
+			port, err := parsePort(value, v.GetSMTPPort())
+			if err != nil {
+				return err
+			}
+
+			if port == v.GetIMAPPort() {
+				return fmt.Errorf("%w: port %v is used by the IMAP server", errInvalidArgument, port)
+			}
+
+			return v.SetSMTPPort(port)
+		},
+	},
+	"imap-ssl": {
+		get: func(v *vault.Vault) any { return v.GetIMAPSSL() },
+		set: func(v *vault.Vault, value string) error {
+			ssl, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetIMAPSSL(ssl)
+		},
+	},
+	"smtp-ssl": {
+		get: func(v *vault.Vault) any { return v.GetSMTPSSL() },
+		set: func(v *vault.Vault, value string) error {
+			ssl, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetSMTPSSL(ssl)
+		},
+	},
+	"imap-listeners": {
+		get: func(v *vault.Vault) any { return formatListeners(v.GetIMAPListeners()) },
+		set: func(v *vault.Vault, value string) error {
+			listeners, err := parseListeners(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetIMAPListeners(listeners)
+		},
+	},
+	"smtp-listeners": {
+		get: func(v *vault.Vault) any { return formatListeners(v.GetSMTPListeners()) },
+		set: func(v *vault.Vault, value string) error {
+			listeners, err := parseListeners(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetSMTPListeners(listeners)
+		},
+	},
+	"unix-sockets": {
+		get: func(v *vault.Vault) any { return v.GetUnixSockets() },
+		set: func(v *vault.Vault, value string) error {
+			unixSockets, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetUnixSockets(unixSockets)
+		},
+	},
+	"client-certs": {
+		get: func(v *vault.Vault) any { return v.GetClientCertMode().String() },
+		set: func(v *vault.Vault, value string) error {
+			mode, err := parseClientCertMode(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetClientCertMode(mode)
+		},
+	},
+	"proxy-allowed": {
+		get: func(v *vault.Vault) any { return v.GetProxyAllowed() },
+		set: func(v *vault.Vault, value string) error {
+			allowed, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetProxyAllowed(allowed)
+		},
+	},
+	"show-all-mail": {
+		get: func(v *vault.Vault) any { return v.GetShowAllMail() },
+		set: func(v *vault.Vault, value string) error {
+			show, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetShowAllMail(show)
+		},
+	},
+	"auto-update": {
+		get: func(v *vault.Vault) any { return v.GetAutoUpdate() },
+		set: func(v *vault.Vault, value string) error {
+			autoUpdate, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetAutoUpdate(autoUpdate)
+		},
+	},
+	"update-channel": {
+		get: func(v *vault.Vault) any { return v.GetUpdateChannel() },
+		set: func(v *vault.Vault, value string) error {
+			switch channel := updater.Channel(value); channel {
+			case updater.StableChannel, updater.EarlyChannel:
+				return v.SetUpdateChannel(channel)
+
+			default:
+				return fmt.Errorf("%w: unknown update channel %q", errInvalidArgument, value)
+			}
+		},
+	},
+	"telemetry-disabled": {
+		get: func(v *vault.Vault) any { return v.GetTelemetryDisabled() },
+		set: func(v *vault.Vault, value string) error {
+			disabled, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return v.SetTelemetryDisabled(disabled)
+		},
+	},
+}
+
+// withCommandAdmin returns an action which runs the given command against the vault, without starting the bridge,
+// so that it can be inspected while the bridge is stopped. The vault is opened read-only: it is left as it is
+// even if it can't be read, and the certificates aren't installed.
+func withCommandAdmin(fn func(c *cli.Context, v *vault.Vault, insecure, corrupt bool) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		reporter := sentry.NewReporter(constants.FullAppName, useragent.New())
+
+		crashHandler := crash.NewHandler(reporter.ReportException)
+		defer async.HandlePanic(crashHandler)
+
+		return toExitError(withCommandLocations(c, crashHandler, func(locations *locations.Locations) error {
+			return withReadOnlyVault(locations, crashHandler, func(v *vault.Vault, insecure, corrupt bool) error {
+				return fn(c, v, insecure, corrupt)
+			})
+		}))
+	}
+}
+
+// withCommandAdminWrite is like withCommandAdmin, for the commands which repair the vault. Opening it for writing
+// resets it if it can't be read, losing the accounts and settings it holds, so the command is refused then unless
+// the reset is confirmed with --reset.
+func withCommandAdminWrite(fn func(c *cli.Context, v *vault.Vault, insecure, corrupt bool) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		reporter := sentry.NewReporter(constants.FullAppName, useragent.New())
+
+		crashHandler := crash.NewHandler(reporter.ReportException)
+		defer async.HandlePanic(crashHandler)
+
+		return toExitError(withCommandLocations(c, crashHandler, func(locations *locations.Locations) error {
+			if !c.Bool(flagAdminReset) {
+				if err := withReadOnlyVault(locations, crashHandler, func(_ *vault.Vault, _, corrupt bool) error {
+					if corrupt {
+						return errVaultCorrupt
+					}
+
+					return nil
+				}); errors.Is(err, vault.ErrNoVaultKey) {
+					return fmt.Errorf("%w: %v", errVaultCorrupt, err)
+				} else if err != nil {
+					return err
+				}
+			}
+
+			encVault, insecure, corrupt, err := newVault(locations, crashHandler)
+			if err != nil {
+				return fmt.Errorf("could not create vault: %w", err)
+			}
+
+			defer func() {
+				if err := encVault.Close(); err != nil {
+					logrus.WithError(err).Error("Failed to close vault")
+				}
+			}()
+
+			return fn(c, encVault, insecure, corrupt)
+		}))
+	}
+}
+
+// showVaultInfo prints where the vault is, its version and whether it could be read.
+func showVaultInfo(c *cli.Context, v *vault.Vault, insecure, corrupt bool) error {
+	info := vaultInfoJSON{
+		Path:           v.Path(),
+		CurrentVersion: vault.Current.String(),
+		Insecure:       insecure,
+		Corrupt:        corrupt,
+		UserCount:      len(v.GetUserIDs()),
+	}
+
+	// A new vault is only written once it is changed.
+	if version, err := vault.ReadVersion(filepath.Dir(v.Path())); errors.Is(err, fs.ErrNotExist) {
+		info.Version = vault.Current.String()
+	} else if err != nil {
+		return fmt.Errorf("could not read vault version: %w", err)
+	} else {
+		info.Version = version.String()
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, info)
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Path:\t%v\n", info.Path)
+	fmt.Fprintf(w, "Version:\t%v (current: %v)\n", info.Version, info.CurrentVersion)
+	fmt.Fprintf(w, "Insecure:\t%v\n", info.Insecure)
+	fmt.Fprintf(w, "Corrupt:\t%v\n", info.Corrupt)
+	fmt.Fprintf(w, "Accounts:\t%v\n", info.UserCount)
+
+	return w.Flush()
+}
+
+func listVaultUsers(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	users := make([]vaultUserJSON, 0, len(v.GetUserIDs()))
+
+	for _, userID := range v.GetUserIDs() {
+		if err := v.GetUser(userID, func(user *vault.User) {
+			users = append(users, newVaultUserJSON(user))
+		}); err != nil {
+			return err
+		}
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, users)
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "USER ID\tUSERNAME\tLOGGED IN\tSYNC\tFAILED MESSAGES\tEVENT ID")
+
+	for _, user := range users {
+		sync := "incomplete"
+
+		if user.SyncStatus.HasLabels && user.SyncStatus.HasMessages {
+			sync = "complete"
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n",
+			user.UserID,
+			user.Username,
+			user.LoggedIn,
+			sync,
+			len(user.SyncStatus.FailedMessageIDs),
+			user.EventID,
+		)
+	}
+
+	return w.Flush()
+}
+
+// removeVaultUser removes an account from the vault, along with its gluon stores and databases,
+// as the bridge does when the account is removed while it runs.
+func removeVaultUser(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("%w: expected exactly one account", errInvalidArgument)
+	}
+
+	userID, err := findVaultUser(v, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	var gluonIDs []string
+
+	if err := v.GetUser(userID, func(user *vault.User) {
+		gluonIDs = maps.Values(user.GetGluonIDs())
+	}); err != nil {
+		return err
+	}
+
+	if err := v.DeleteUser(userID); err != nil {
+		return err
+	}
+
+	return WithLocations(func(locations *locations.Locations) error {
+		gluonDataDir, err := locations.ProvideGluonDataPath()
+		if err != nil {
+			return err
+		}
+
+		return bridge.DeleteGluonUsers(v.GetGluonCacheDir(), gluonDataDir, gluonIDs)
+	})
+}
+
+// clearVaultUserSync clears the sync status of an account, so that it is fully synced again when the bridge starts.
+func clearVaultUserSync(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("%w: expected exactly one account", errInvalidArgument)
+	}
+
+	return withVaultUser(v, c.Args().First(), (*vault.User).ClearSyncStatus)
+}
+
+// clearVaultUserFailed clears the messages of an account which failed to be synced.
+func clearVaultUserFailed(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("%w: expected exactly one account", errInvalidArgument)
+	}
+
+	return withVaultUser(v, c.Args().First(), (*vault.User).ClearFailedMessageIDs)
+}
+
+// resetVaultUserEventID sets the event from which the events of an account are processed when the bridge starts.
+func resetVaultUserEventID(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	if c.NArg() != 2 || c.Args().Get(1) == "" {
+		return fmt.Errorf("%w: expected an account and an event ID", errInvalidArgument)
+	}
+
+	return withVaultUser(v, c.Args().First(), func(user *vault.User) error {
+		return user.SetEventID(c.Args().Get(1))
+	})
+}
+
+func getVaultSettings(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	var names []string
+
+	switch c.NArg() {
+	case 0:
+		for name := range vaultSettings {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+	case 1:
+		if _, ok := vaultSettings[c.Args().First()]; !ok {
+			return fmt.Errorf("%w: unknown setting %q", errInvalidArgument, c.Args().First())
+		}
+
+		names = []string{c.Args().First()}
+
+	default:
+		return fmt.Errorf("%w: expected at most one setting", errInvalidArgument)
+	}
+
+	values := make(map[string]any, len(names))
+
+	for _, name := range names {
+		values[name] = vaultSettings[name].get(v)
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, values)
+	}
+
+	if c.NArg() == 1 {
+		_, err := fmt.Fprintln(c.App.Writer, values[names[0]])
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%s\t%v\n", name, values[name])
+	}
+
+	return w.Flush()
+}
+
+func setVaultSetting(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected a setting and a value", errInvalidArgument)
+	}
+
+	setting, ok := vaultSettings[c.Args().First()]
+	if !ok {
+		return fmt.Errorf("%w: unknown setting %q", errInvalidArgument, c.Args().First())
+	}
+
+	return setting.set(v, c.Args().Get(1))
+}
+
+// exportVault prints the vault data as JSON, leaving out the secrets unless they are explicitly requested.
+func exportVault(c *cli.Context, v *vault.Vault, _, _ bool) error {
+	b, err := v.Export(c.Bool(flagAdminShowSecrets))
+	if err != nil {
+		return fmt.Errorf("could not export vault: %w", err)
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, string(b))
+
+	return err
+}
+
+// withVaultUser runs the given function on the account matching the given user ID, username or primary address.
+func withVaultUser(v *vault.Vault, query string, fn func(*vault.User) error) error {
+	userID, err := findVaultUser(v, query)
+	if err != nil {
+		return err
+	}
+
+	var fnErr error
+
+	if err := v.GetUser(userID, func(user *vault.User) { fnErr = fn(user) }); err != nil {
+		return err
+	}
+
+	return fnErr
+}
+
+// findVaultUser returns the ID of the account in the vault matching the given user ID, username or primary address.
+func findVaultUser(v *vault.Vault, query string) (string, error) {
+	for _, userID := range v.GetUserIDs() {
+		var match bool
+
+		if err := v.GetUser(userID, func(user *vault.User) {
+			match = userID == query || user.Username() == query || user.PrimaryEmail() == query
+		}); err != nil {
+			return "", err
+		}
+
+		if match {
+			return userID, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %v", errNoSuchAccount, query)
+}
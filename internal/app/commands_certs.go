@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagCertsNSS    = "nss"
+	flagCertsSystem = "system"
+)
+
+func installCerts(c *cli.Context, b *bridge.Bridge, _ <-chan events.Event) error {
+	certPEM, _ := b.GetBridgeTLSCert()
+
+	updated, err := certs.NewInstaller().InstallCertToStores(certPEM, getTrustStores(c))
+
+	return printUpdatedStores(c, updated, err)
+}
+
+func uninstallCerts(c *cli.Context, b *bridge.Bridge, _ <-chan events.Event) error {
+	certPEM, _ := b.GetBridgeTLSCert()
+
+	updated, err := certs.NewInstaller().UninstallCertFromStores(certPEM, getTrustStores(c))
+
+	return printUpdatedStores(c, updated, err)
+}
+
+func getTrustStores(c *cli.Context) certs.TrustStores {
+	return certs.TrustStores{
+		NSS:    c.Bool(flagCertsNSS),
+		System: c.Bool(flagCertsSystem),
+	}
+}
+
+// printUpdatedStores prints the trust stores which were updated, even if others failed to be.
+func printUpdatedStores(c *cli.Context, updated []string, err error) error {
+	if c.Bool(flagJSON) {
+		if updated == nil {
+			updated = []string{}
+		}
+
+		if err := printJSON(c, updated); err != nil {
+			return err
+		}
+	} else {
+		for _, store := range updated {
+			fmt.Fprintln(c.App.Writer, store)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagClientCertOutput       = "output"
+	flagClientCertSkipPassword = "skip-password"
+	flagClientCertFingerprint  = "fingerprint"
+	flagClientCertSubject      = "subject"
+)
+
+type clientCertJSON struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Fingerprint  string    `json:"fingerprint,omitempty"`
+	Subject      string    `json:"subject,omitempty"`
+	SkipPassword bool      `json:"skipPassword"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type enrolledClientCertJSON struct {
+	clientCertJSON
+
+	Path     string `json:"path"`
+	Password string `json:"password"`
+}
+
+func newClientCertJSON(clientCert bridge.ClientCertInfo) clientCertJSON {
+	return clientCertJSON{
+		ID:           clientCert.ID,
+		Name:         clientCert.Name,
+		Fingerprint:  clientCert.Fingerprint,
+		Subject:      clientCert.Subject,
+		SkipPassword: clientCert.SkipPassword,
+		CreatedAt:    clientCert.CreatedAt,
+	}
+}
+
+func listClientCerts(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	user, err := getAccountArg(c, b)
+	if err != nil {
+		return err
+	}
+
+	clientCerts, err := b.GetClientCerts(user.UserID)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, xslices.Map(clientCerts, newClientCertJSON))
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tNAME\tMATCHES\tSKIP PASSWORD\tCREATED AT")
+
+	for _, clientCert := range clientCerts {
+		matches := "fingerprint " + clientCert.Fingerprint
+
+		if clientCert.Fingerprint == "" {
+			matches = "subject " + clientCert.Subject
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\n",
+			clientCert.ID,
+			clientCert.Name,
+			matches,
+			clientCert.SkipPassword,
+			clientCert.CreatedAt.Format(time.RFC3339),
+		)
+	}
+
+	return w.Flush()
+}
+
+func enrollClientCert(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and a name", errInvalidArgument)
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	// Don't overwrite an existing file, e.g. a previously enrolled certificate.
+	file, err := os.OpenFile(c.String(flagClientCertOutput), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	clientCert, pkcs12, password, err := b.EnrollClientCert(user.UserID, c.Args().Get(1), c.Bool(flagClientCertSkipPassword))
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+
+		return err
+	}
+
+	if _, err := file.Write(pkcs12); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, enrolledClientCertJSON{
+			clientCertJSON: newClientCertJSON(clientCert),
+			Path:           file.Name(),
+			Password:       string(password),
+		})
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, string(password))
+
+	return err
+}
+
+func addClientCert(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and a name", errInvalidArgument)
+	}
+
+	if (c.String(flagClientCertFingerprint) == "") == (c.String(flagClientCertSubject) == "") {
+		return fmt.Errorf("%w: expected either a fingerprint or a subject", errInvalidArgument)
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	clientCert, err := b.AddClientCert(
+		user.UserID,
+		c.Args().Get(1),
+		c.String(flagClientCertFingerprint),
+		c.String(flagClientCertSubject),
+		c.Bool(flagClientCertSkipPassword),
+	)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, newClientCertJSON(clientCert))
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, clientCert.ID)
+
+	return err
+}
+
+func removeClientCert(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected an account and a client certificate", errInvalidArgument)
+	}
+
+	user, err := findAccount(b, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	clientCerts, err := b.GetClientCerts(user.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, clientCert := range clientCerts {
+		if clientCert.ID == c.Args().Get(1) || clientCert.Name == c.Args().Get(1) {
+			return b.RemoveClientCert(user.UserID, clientCert.ID)
+		}
+	}
+
+	return fmt.Errorf("%w: %v", vault.ErrNoSuchClientCert, c.Args().Get(1))
+}
+
+// exportClientCA writes the certificate and key of the client CA to the given directory,
+// without overwriting existing files.
+func exportClientCA(c *cli.Context, b *bridge.Bridge, _ <-chan events.Event) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("%w: expected a directory", errInvalidArgument)
+	}
+
+	certPEM, keyPEM := b.GetClientCA()
+
+	for name, data := range map[string][]byte{"cert.pem": certPEM, "key.pem": keyPEM} {
+		file, err := os.OpenFile(filepath.Join(c.Args().First(), name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return err
+		}
+
+		if _, err := file.Write(data); err != nil {
+			_ = file.Close()
+			return err
+		}
+
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintln(c.App.Writer, "Client CA exported to", c.Args().First())
+
+	return err
+}
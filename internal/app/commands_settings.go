@@ -0,0 +1,417 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/maps"
+)
+
+// setting describes a bridge setting which can be read and changed with the settings commands.
+type setting struct {
+	get func(*bridge.Bridge) any
+	set func(context.Context, *bridge.Bridge, string) error
+}
+
+// settings holds the settings which can be read and changed with the settings commands, keyed by name.
+var settings = map[string]setting{ //nolint:gochecknoglobals
+	"imap-port": {
+		get: func(b *bridge.Bridge) any { return b.GetIMAPPort() },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			port, err := parsePort(value, b.GetIMAPPort())
+			if err != nil {
+				return err
+			}
+
+			return b.SetIMAPPort(ctx, port)
+		},
+	},
+	"smtp-port": {
+		get: func(b *bridge.Bridge) any { return b.GetSMTPPort() },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			port, err := parsePort(value, b.GetSMTPPort())
+			if err != nil {
+				return err
+			}
+
+			return b.SetSMTPPort(ctx, port)
+		},
+	},
+	"imap-ssl": {
+		get: func(b *bridge.Bridge) any { return b.GetIMAPSSL() },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			ssl, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetIMAPSSL(ctx, ssl)
+		},
+	},
+	"smtp-ssl": {
+		get: func(b *bridge.Bridge) any { return b.GetSMTPSSL() },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			ssl, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetSMTPSSL(ctx, ssl)
+		},
+	},
+	"imap-listeners": {
+		get: func(b *bridge.Bridge) any { return formatListeners(b.GetIMAPListeners()) },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			listeners, err := parseListeners(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetIMAPListeners(ctx, listeners)
+		},
+	},
+	"smtp-listeners": {
+		get: func(b *bridge.Bridge) any { return formatListeners(b.GetSMTPListeners()) },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			listeners, err := parseListeners(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetSMTPListeners(ctx, listeners)
+		},
+	},
+	"unix-sockets": {
+		get: func(b *bridge.Bridge) any { return b.GetUnixSockets() },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			unixSockets, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetUnixSockets(ctx, unixSockets)
+		},
+	},
+	"tls-cert-hosts": {
+		get: func(b *bridge.Bridge) any { return b.GetTLSCertHosts() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			err := b.RegenerateTLSCert(parseTLSCertHosts(value), b.GetTLSKeyType())
+			if errors.Is(err, certs.ErrInvalidHost) {
+				return fmt.Errorf("%w: %v", errInvalidArgument, err)
+			}
+
+			return err
+		},
+	},
+	"tls-key-type": {
+		get: func(b *bridge.Bridge) any { return b.GetTLSKeyType().String() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			keyType, err := certs.ParseKeyType(value)
+			if err != nil {
+				return fmt.Errorf("%w: %v", errInvalidArgument, err)
+			}
+
+			return b.RegenerateTLSCert(b.GetTLSCertHosts(), keyType)
+		},
+	},
+	"tls-cert-path": {
+		get: func(b *bridge.Bridge) any {
+			if certPath, keyPath := b.GetBridgeTLSCertPath(); certPath != "" {
+				return certPath + "," + keyPath
+			}
+
+			return ""
+		},
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			if value == "" {
+				return b.ClearBridgeTLSCertPath()
+			}
+
+			certPath, keyPath, ok := strings.Cut(value, ",")
+			if !ok {
+				return fmt.Errorf("%w: expected the certificate and key paths separated by a comma", errInvalidArgument)
+			}
+
+			if err := b.SetBridgeTLSCertPath(strings.TrimSpace(certPath), strings.TrimSpace(keyPath)); err != nil {
+				return fmt.Errorf("%w: %v", errInvalidArgument, err)
+			}
+
+			return nil
+		},
+	},
+	"client-certs": {
+		get: func(b *bridge.Bridge) any { return b.GetClientCertMode().String() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			mode, err := parseClientCertMode(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetClientCertMode(mode)
+		},
+	},
+	"proxy-allowed": {
+		get: func(b *bridge.Bridge) any { return b.GetProxyAllowed() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			allowed, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetProxyAllowed(allowed)
+		},
+	},
+	"show-all-mail": {
+		get: func(b *bridge.Bridge) any { return b.GetShowAllMail() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			show, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetShowAllMail(show)
+		},
+	},
+	"autostart": {
+		get: func(b *bridge.Bridge) any { return b.GetAutostart() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			autostart, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetAutostart(autostart)
+		},
+	},
+	"auto-update": {
+		get: func(b *bridge.Bridge) any { return b.GetAutoUpdate() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			autoUpdate, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetAutoUpdate(autoUpdate)
+		},
+	},
+	"update-channel": {
+		get: func(b *bridge.Bridge) any { return b.GetUpdateChannel() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			switch channel := updater.Channel(value); channel {
+			case updater.StableChannel, updater.EarlyChannel:
+				return b.SetUpdateChannel(channel)
+
+			default:
+				return fmt.Errorf("%w: unknown update channel %q", errInvalidArgument, value)
+			}
+		},
+	},
+	"telemetry-disabled": {
+		get: func(b *bridge.Bridge) any { return b.GetTelemetryDisabled() },
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			disabled, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+
+			return b.SetTelemetryDisabled(disabled)
+		},
+	},
+	"keychain": {
+		get: func(b *bridge.Bridge) any {
+			helper, err := b.GetKeychainApp()
+			if err != nil {
+				logrus.WithError(err).Error("Failed to get keychain helper")
+			}
+
+			return helper
+		},
+		set: func(_ context.Context, b *bridge.Bridge, value string) error {
+			if _, ok := keychain.Helpers[value]; !ok {
+				helpers := maps.Keys(keychain.Helpers)
+
+				sort.Strings(helpers)
+
+				return fmt.Errorf("%w: unknown keychain %q (%v)", errInvalidArgument, value, strings.Join(helpers, ", "))
+			}
+
+			return b.SetKeychainApp(value)
+		},
+	},
+	"cache-location": {
+		get: func(b *bridge.Bridge) any { return b.GetGluonCacheDir() },
+		set: func(ctx context.Context, b *bridge.Bridge, value string) error {
+			return b.SetGluonDir(ctx, value)
+		},
+	},
+}
+
+func getSettings(c *cli.Context, b *bridge.Bridge, _ <-chan events.Event) error {
+	var names []string
+
+	switch c.NArg() {
+	case 0:
+		for name := range settings {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+	case 1:
+		if _, ok := settings[c.Args().First()]; !ok {
+			return fmt.Errorf("%w: unknown setting %q", errInvalidArgument, c.Args().First())
+		}
+
+		names = []string{c.Args().First()}
+
+	default:
+		return fmt.Errorf("%w: expected at most one setting", errInvalidArgument)
+	}
+
+	values := make(map[string]any, len(names))
+
+	for _, name := range names {
+		values[name] = settings[name].get(b)
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, values)
+	}
+
+	if c.NArg() == 1 {
+		_, err := fmt.Fprintln(c.App.Writer, values[names[0]])
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%s\t%v\n", name, values[name])
+	}
+
+	return w.Flush()
+}
+
+func setSetting(c *cli.Context, b *bridge.Bridge, _ <-chan events.Event) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("%w: expected a setting and a value", errInvalidArgument)
+	}
+
+	setting, ok := settings[c.Args().First()]
+	if !ok {
+		return fmt.Errorf("%w: unknown setting %q", errInvalidArgument, c.Args().First())
+	}
+
+	return setting.set(c.Context, b, c.Args().Get(1))
+}
+
+// parsePort parses a port number and checks that it can be used, unless it is the current port.
+func parsePort(value string, current int) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%w: %q is not a valid port number", errInvalidArgument, value)
+	}
+
+	if port != current && !ports.IsPortFree(port) {
+		return 0, fmt.Errorf("port %v is occupied by another process", port)
+	}
+
+	return port, nil
+}
+
+// parseTLSCertHosts parses a comma-separated list of hostnames and IP addresses.
+// An empty value means the default host.
+func parseTLSCertHosts(value string) []string {
+	var hosts []string
+
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			hosts = append(hosts, field)
+		}
+	}
+
+	return hosts
+}
+
+// parseListeners parses a comma-separated list of listener specs, as parsed by vault.ParseListenerSpec.
+// An empty value means no listeners.
+func parseListeners(value string) ([]vault.ListenerSpec, error) {
+	var listeners []vault.ListenerSpec
+
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+
+		listener, err := vault.ParseListenerSpec(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidArgument, err)
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return listeners, nil
+}
+
+// formatListeners returns the listener specs in the form parsed by vault.ParseListenerSpec.
+func formatListeners(listeners []vault.ListenerSpec) []string {
+	res := make([]string, 0, len(listeners))
+
+	for _, listener := range listeners {
+		res = append(res, listener.String())
+	}
+
+	return res
+}
+
+func parseBool(value string) (bool, error) {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%w: %q is not a valid boolean", errInvalidArgument, value)
+	}
+
+	return b, nil
+}
+
+// parseClientCertMode parses a client certificate mode as printed by the settings commands.
+func parseClientCertMode(value string) (vault.ClientCertMode, error) {
+	for _, mode := range []vault.ClientCertMode{vault.ClientCertsOff, vault.ClientCertsOptional, vault.ClientCertsRequired} {
+		if mode.String() == value {
+			return mode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: unknown client certificate mode %q (off, optional or required)", errInvalidArgument, value)
+}
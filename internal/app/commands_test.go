@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/allan-simon/go-singleinstance"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestToExitError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{err: errors.New("some error"), code: exitCodeError},
+		{err: fmt.Errorf("%w: missing account", errInvalidArgument), code: exitCodeUsage},
+		{err: errAlreadyRunning, code: exitCodeAlreadyRunning},
+		{err: errNoSuchAccount, code: exitCodeNotFound},
+		{err: fmt.Errorf("failed to get user: %w", bridge.ErrNoSuchUser), code: exitCodeNotFound},
+		{err: vault.ErrNoSuchAppPassword, code: exitCodeNotFound},
+		{err: errMissingTOTP, code: exitCodeAuth},
+		{err: errMissingMailboxPassword, code: exitCodeAuth},
+
+		// Only API errors rejecting the credentials are authentication failures.
+		{err: &proton.APIError{Status: http.StatusUnprocessableEntity, Code: proton.PasswordWrong}, code: exitCodeAuth},
+		{err: &proton.APIError{Status: http.StatusBadRequest, Code: proton.AuthRefreshTokenInvalid}, code: exitCodeAuth},
+		{err: fmt.Errorf("failed to login: %w", &proton.APIError{Status: http.StatusUnauthorized}), code: exitCodeAuth},
+		{err: &proton.APIError{Status: http.StatusTooManyRequests}, code: exitCodeError},
+		{err: &proton.APIError{Status: http.StatusServiceUnavailable}, code: exitCodeError},
+		{err: &proton.APIError{Status: http.StatusBadRequest, Code: proton.AppVersionBadCode}, code: exitCodeError},
+		{err: &proton.NetError{Cause: errors.New("connection refused")}, code: exitCodeError},
+	}
+
+	for _, test := range tests {
+		requireExitCode(t, test.code, toExitError(test.err))
+	}
+
+	require.NoError(t, toExitError(nil))
+}
+
+func TestWithCommandLocations_AlreadyRunning(t *testing.T) {
+	// Keep the locations, and the lock file in particular, out of the user's directories.
+	for _, env := range []string{"HOME", "XDG_CONFIG_HOME", "XDG_CACHE_HOME", "XDG_DATA_HOME", "APPDATA", "LOCALAPPDATA"} {
+		t.Setenv(env, t.TempDir())
+	}
+
+	require.NoError(t, WithLocations(func(l *locations.Locations) error {
+		// Another instance is running.
+		lock, err := singleinstance.CreateLockFile(l.GetLockFile())
+		require.NoError(t, err)
+		defer func() { require.NoError(t, lock.Close()) }()
+
+		err = withCommandLocations(cli.NewContext(cli.NewApp(), nil, nil), crash.NewHandler(), func(*locations.Locations) error {
+			t.Fatal("The command must not run while another instance is running")
+			return nil
+		})
+		require.ErrorIs(t, err, errAlreadyRunning)
+		requireExitCode(t, exitCodeAlreadyRunning, toExitError(err))
+
+		return nil
+	}))
+}
+
+func requireExitCode(t *testing.T, code int, err error) {
+	t.Helper()
+
+	exitErr := cli.ExitCoder(nil)
+	require.ErrorAs(t, err, &exitErr)
+	require.Equal(t, code, exitErr.ExitCode(), err.Error())
+}
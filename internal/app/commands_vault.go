@@ -0,0 +1,133 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/urfave/cli/v2"
+)
+
+type generationJSON struct {
+	Generation int       `json:"generation"`
+	ModTime    time.Time `json:"modTime"`
+	UserCount  int       `json:"userCount"`
+	Error      string    `json:"error,omitempty"`
+}
+
+func newGenerationJSON(generation vault.Generation) generationJSON {
+	res := generationJSON{
+		Generation: generation.Index,
+		ModTime:    generation.ModTime,
+		UserCount:  generation.UserCount,
+	}
+
+	if generation.Err != nil {
+		res.Error = generation.Err.Error()
+	}
+
+	return res
+}
+
+// restoreVault lists the generations of the vault when no generation is given, or restores the given one.
+func restoreVault(c *cli.Context, vaultDir string, vaultKey []byte) error {
+	switch c.NArg() {
+	case 0:
+		return listGenerations(c, vaultDir, vaultKey)
+
+	case 1:
+		idx, err := strconv.Atoi(c.Args().First())
+		if err != nil {
+			return fmt.Errorf("%w: %q is not a valid generation", errInvalidArgument, c.Args().First())
+		}
+
+		if err := vault.RestoreGeneration(vaultDir, vaultKey, idx); err != nil {
+			return err
+		}
+
+		if c.Bool(flagJSON) {
+			return printJSON(c, map[string]int{"restored": idx})
+		}
+
+		_, err = fmt.Fprintf(c.App.Writer, "Restored generation %d; the previous vault was kept as generation 1.\n", idx)
+
+		return err
+
+	default:
+		return fmt.Errorf("%w: expected at most one generation", errInvalidArgument)
+	}
+}
+
+// rekeyVault rotates the vault key and the keys of the accounts' cached messages, re-encrypting them.
+func rekeyVault(c *cli.Context, b *bridge.Bridge, eventCh <-chan events.Event) error {
+	if err := waitForUsersLoaded(c.Context, eventCh); err != nil {
+		return err
+	}
+
+	if err := b.RekeyVault(c.Context); err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, map[string]bool{"rekeyed": true})
+	}
+
+	_, err := fmt.Fprintln(c.App.Writer, "The vault and the cached messages were re-encrypted with new keys.")
+
+	return err
+}
+
+func listGenerations(c *cli.Context, vaultDir string, vaultKey []byte) error {
+	generations, err := vault.ListGenerations(vaultDir, vaultKey)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, xslices.Map(generations, newGenerationJSON))
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "GENERATION\tWRITTEN AT\tUSERS")
+
+	for _, generation := range generations {
+		name := strconv.Itoa(generation.Index)
+
+		if generation.Index == 0 {
+			name += " (current)"
+		}
+
+		users := strconv.Itoa(generation.UserCount)
+
+		if generation.Err != nil {
+			users = "unreadable: " + generation.Err.Error()
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, generation.ModTime.Format(time.RFC3339), users)
+	}
+
+	return w.Flush()
+}
@@ -20,11 +20,13 @@ package app
 import (
 	"fmt"
 
+	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	bridgeCLI "github.com/ProtonMail/proton-bridge/v3/internal/frontend/cli"
 	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/rest"
 	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/restarter"
 	"github.com/sirupsen/logrus"
@@ -44,6 +46,21 @@ func runFrontend(
 	logrus.Debug("Running frontend")
 	defer logrus.Debug("Frontend stopped")
 
+	if c.Bool(flagRESTAPI) {
+		server, err := rest.NewServer(crashHandler, locations, bridge, quitCh, c.Int(flagRESTAPIPort))
+		if err != nil {
+			return fmt.Errorf("could not create REST API server: %w", err)
+		}
+
+		go func() {
+			defer async.HandlePanic(crashHandler)
+
+			if err := server.Loop(); err != nil {
+				logrus.WithError(err).Error("REST API server stopped")
+			}
+		}()
+	}
+
 	switch {
 	case c.Bool(flagCLI):
 		return bridgeCLI.New(bridge, restarter, eventCh, crashHandler, quitCh).Loop()
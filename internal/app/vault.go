@@ -18,6 +18,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"path"
 
@@ -45,6 +46,13 @@ func WithVault(locations *locations.Locations, panicHandler async.PanicHandler,
 		"corrupt":  corrupt,
 	}).Debug("Vault created")
 
+	// Write the pending changes to the vault when done.
+	defer func() {
+		if err := encVault.Close(); err != nil {
+			logrus.WithError(err).Error("Failed to close vault")
+		}
+	}()
+
 	// Install the certificates if needed.
 	if installed := encVault.GetCertsInstalled(); !installed {
 		logrus.Debug("Installing certificates")
@@ -62,32 +70,40 @@ func WithVault(locations *locations.Locations, panicHandler async.PanicHandler,
 		logrus.Debug("Certificates successfully installed")
 	}
 
-	// GODT-1950: Add teardown actions (e.g. to close the vault).
-
 	return fn(encVault, insecure, corrupt)
 }
 
-func newVault(locations *locations.Locations, panicHandler async.PanicHandler) (*vault.Vault, bool, bool, error) {
-	vaultDir, err := locations.ProvideSettingsPath()
+// withReadOnlyVault is like WithVault, but opens the vault read-only, so that it can be inspected without changing it:
+// a vault which can't be read isn't reset, no vault key is created if there is none, and the certificates aren't installed.
+func withReadOnlyVault(locations *locations.Locations, panicHandler async.PanicHandler, fn func(*vault.Vault, bool, bool) error) error {
+	vaultDir, vaultKey, insecure, err := getVaultDirAndKey(locations, false)
 	if err != nil {
-		return nil, false, false, fmt.Errorf("could not get vault dir: %w", err)
+		return err
 	}
 
-	logrus.WithField("vaultDir", vaultDir).Debug("Loading vault from directory")
+	gluonCacheDir, err := locations.ProvideGluonCachePath()
+	if err != nil {
+		return fmt.Errorf("could not provide gluon path: %w", err)
+	}
 
-	var (
-		vaultKey []byte
-		insecure bool
-	)
+	encVault, corrupt, err := vault.Open(vaultDir, gluonCacheDir, vaultKey, panicHandler)
+	if err != nil {
+		return fmt.Errorf("could not open vault: %w", err)
+	}
 
-	if key, err := loadVaultKey(vaultDir); err != nil {
-		logrus.WithError(err).Error("Could not load/create vault key")
-		insecure = true
+	defer func() {
+		if err := encVault.Close(); err != nil {
+			logrus.WithError(err).Error("Failed to close vault")
+		}
+	}()
 
-		// We store the insecure vault in a separate directory
-		vaultDir = path.Join(vaultDir, "insecure")
-	} else {
-		vaultKey = key
+	return fn(encVault, insecure, corrupt)
+}
+
+func newVault(locations *locations.Locations, panicHandler async.PanicHandler) (*vault.Vault, bool, bool, error) {
+	vaultDir, vaultKey, insecure, err := getVaultDirAndKey(locations, true)
+	if err != nil {
+		return nil, false, false, err
 	}
 
 	gluonCacheDir, err := locations.ProvideGluonCachePath()
@@ -103,7 +119,31 @@ func newVault(locations *locations.Locations, panicHandler async.PanicHandler) (
 	return vault, insecure, corrupt, nil
 }
 
-func loadVaultKey(vaultDir string) ([]byte, error) {
+// getVaultDirAndKey returns the directory of the vault and its key, and whether it is insecure
+// because the key could not be loaded from the keychain. If there is no key, one is created if create is true,
+// otherwise vault.ErrNoVaultKey is returned.
+func getVaultDirAndKey(locations *locations.Locations, create bool) (string, []byte, bool, error) {
+	vaultDir, err := locations.ProvideSettingsPath()
+	if err != nil {
+		return "", nil, false, fmt.Errorf("could not get vault dir: %w", err)
+	}
+
+	logrus.WithField("vaultDir", vaultDir).Debug("Loading vault from directory")
+
+	key, err := loadVaultKey(vaultDir, create)
+	if errors.Is(err, vault.ErrNoVaultKey) {
+		return "", nil, false, err
+	} else if err != nil {
+		logrus.WithError(err).Error("Could not load/create vault key")
+
+		// We store the insecure vault in a separate directory
+		return path.Join(vaultDir, "insecure"), nil, true, nil
+	}
+
+	return vaultDir, key, false, nil
+}
+
+func loadVaultKey(vaultDir string, create bool) ([]byte, error) {
 	helper, err := vault.GetHelper(vaultDir)
 	if err != nil {
 		return nil, fmt.Errorf("could not get keychain helper: %w", err)
@@ -123,5 +163,9 @@ func loadVaultKey(vaultDir string) ([]byte, error) {
 		return vault.GetVaultKey(kc)
 	}
 
+	if !create {
+		return nil, vault.ErrNoVaultKey
+	}
+
 	return vault.NewVaultKey(kc)
 }
@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+	"github.com/bradenaw/juniper/xslices"
+)
+
+// AppPasswordInfo describes an app password, which can be used instead of the bridge password
+// to authenticate over IMAP and SMTP.
+type AppPasswordInfo struct {
+	// ID is the ID of the app password.
+	ID string
+
+	// Name is the name given to the app password, usually that of the device using it.
+	Name string
+
+	// Scope restricts what the app password can be used for.
+	Scope vault.AppPasswordScope
+
+	// CreatedAt is the time at which the app password was created.
+	CreatedAt time.Time
+
+	// LastUsedAt is the time at which the app password was last used to authenticate, or zero if it never was.
+	LastUsedAt time.Time
+}
+
+// GetAppPasswords returns the app passwords of the given user, oldest first.
+func (bridge *Bridge) GetAppPasswords(userID string) ([]AppPasswordInfo, error) {
+	var appPasswords []vault.AppPassword
+
+	if err := bridge.withVaultUser(userID, func(user *vault.User) error {
+		appPasswords = user.GetAppPasswords()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return xslices.Map(appPasswords, newAppPasswordInfo), nil
+}
+
+// CreateAppPassword creates a new app password with the given name and scope for the given user.
+// It returns the password, encoded like the bridge password; it can't be retrieved afterwards.
+func (bridge *Bridge) CreateAppPassword(userID, name string, scope vault.AppPasswordScope) (AppPasswordInfo, []byte, error) {
+	var (
+		appPass  vault.AppPassword
+		password []byte
+	)
+
+	if err := bridge.withVaultUser(userID, func(user *vault.User) error {
+		var err error
+
+		appPass, password, err = user.AddAppPassword(name, scope)
+
+		return err
+	}); err != nil {
+		return AppPasswordInfo{}, nil, err
+	}
+
+	return newAppPasswordInfo(appPass), algo.B64RawEncode(password), nil
+}
+
+// RevokeAppPassword revokes the given app password of the given user.
+// Clients using it can no longer authenticate.
+func (bridge *Bridge) RevokeAppPassword(userID, appPassID string) error {
+	return bridge.withVaultUser(userID, func(user *vault.User) error {
+		return user.RemoveAppPassword(appPassID)
+	})
+}
+
+// withVaultUser calls fn with the vault data of the given user, who may or may not be logged in.
+func (bridge *Bridge) withVaultUser(userID string, fn func(*vault.User) error) error {
+	return safe.RLockRet(func() error {
+		if !bridge.vault.HasUser(userID) {
+			return ErrNoSuchUser
+		}
+
+		var err error
+
+		if vaultErr := bridge.vault.GetUser(userID, func(user *vault.User) {
+			err = fn(user)
+		}); vaultErr != nil {
+			return vaultErr
+		}
+
+		return err
+	}, bridge.usersLock)
+}
+
+func newAppPasswordInfo(appPass vault.AppPassword) AppPasswordInfo {
+	return AppPasswordInfo{
+		ID:         appPass.ID,
+		Name:       appPass.Name,
+		Scope:      appPass.Scope,
+		CreatedAt:  appPass.CreatedAt,
+		LastUsedAt: appPass.LastUsedAt,
+	}
+}
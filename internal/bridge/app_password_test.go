@@ -0,0 +1,430 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_AppPasswords(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(b)
+			defer imapWaiter.Done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// Create an app password for each device.
+			laptop, laptopPass, err := b.CreateAppPassword(userID, "laptop", vault.AppPasswordScope{})
+			require.NoError(t, err)
+
+			_, phonePass, err := b.CreateAppPassword(userID, "phone", vault.AppPasswordScope{})
+			require.NoError(t, err)
+
+			// Names are unique.
+			_, _, err = b.CreateAppPassword(userID, "laptop", vault.AppPasswordScope{})
+			require.ErrorIs(t, err, vault.ErrAppPasswordExists)
+
+			// The bridge password and both app passwords can be used to log in.
+			for _, pass := range [][]byte{info.BridgePass, laptopPass, phonePass} {
+				imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				require.NoError(t, imapClient.Login(info.Addresses[0], string(pass)))
+				require.NoError(t, imapClient.Logout())
+			}
+
+			// The last use of the app passwords is recorded.
+			appPasswords, err := b.GetAppPasswords(userID)
+			require.NoError(t, err)
+			require.Len(t, appPasswords, 2)
+			require.Equal(t, "laptop", appPasswords[0].Name)
+			require.Equal(t, "phone", appPasswords[1].Name)
+			require.False(t, appPasswords[0].LastUsedAt.IsZero())
+			require.False(t, appPasswords[1].LastUsedAt.IsZero())
+
+			// Revoke the laptop's app password.
+			require.NoError(t, b.RevokeAppPassword(userID, laptop.ID))
+			require.ErrorIs(t, b.RevokeAppPassword(userID, laptop.ID), vault.ErrNoSuchAppPassword)
+
+			// It can no longer be used to log in, but the others still can.
+			imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			defer func() { _ = imapClient.Logout() }()
+
+			require.Error(t, imapClient.Login(info.Addresses[0], string(laptopPass)))
+			require.NoError(t, imapClient.Login(info.Addresses[0], string(phonePass)))
+		})
+	})
+}
+
+func TestBridge_AppPasswordScopes(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		userID, _, err := s.CreateUser("scoped", password)
+		require.NoError(t, err)
+
+		_, err = s.CreateAddress(userID, "alias@"+s.GetDomain(), password)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(b)
+			defer imapWaiter.Done()
+
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			userID, err := b.LoginFull(ctx, "scoped", password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+			smtpWaiter.Wait()
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.Len(t, info.Addresses, 2)
+
+			_, readOnlyPass, err := b.CreateAppPassword(userID, "monitoring", vault.AppPasswordScope{
+				IMAP: vault.IMAPReadOnly,
+				SMTP: vault.SMTPDenied,
+			})
+			require.NoError(t, err)
+
+			_, sendOnlyPass, err := b.CreateAppPassword(userID, "alerting", vault.AppPasswordScope{
+				IMAP:      vault.IMAPDenied,
+				Addresses: []string{info.Addresses[0]},
+			})
+			require.NoError(t, err)
+
+			// A read-only session can read but not modify the mailbox.
+			func() {
+				imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				defer func() { _ = imapClient.Logout() }()
+
+				require.NoError(t, imapClient.Login(info.Addresses[0], string(readOnlyPass)))
+
+				_, err = imapClient.Select("INBOX", false)
+				require.NoError(t, err)
+
+				require.Error(t, imapClient.Create("Folders/readonly"))
+			}()
+
+			// Other sessions still can.
+			func() {
+				imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				defer func() { _ = imapClient.Logout() }()
+
+				require.NoError(t, imapClient.Login(info.Addresses[0], string(info.BridgePass)))
+				require.NoError(t, imapClient.Create("Folders/readwrite"))
+			}()
+
+			// A send-only password can't be used over IMAP.
+			func() {
+				imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				defer func() { _ = imapClient.Logout() }()
+
+				require.Error(t, imapClient.Login(info.Addresses[0], string(sendOnlyPass)))
+			}()
+
+			// An IMAP-only password can't be used over SMTP.
+			func() {
+				smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+				require.NoError(t, err)
+				defer func() { _ = smtpClient.Close() }()
+
+				require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+				require.Error(t, smtpClient.Auth(sasl.NewPlainClient("", info.Addresses[0], string(readOnlyPass))))
+			}()
+
+			// A password restricted to an address can't be used with the others, nor send from them.
+			func() {
+				smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+				require.NoError(t, err)
+				defer func() { _ = smtpClient.Close() }()
+
+				require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+				require.Error(t, smtpClient.Auth(sasl.NewPlainClient("", info.Addresses[1], string(sendOnlyPass))))
+				require.NoError(t, smtpClient.Auth(sasl.NewPlainClient("", info.Addresses[0], string(sendOnlyPass))))
+
+				err = smtpClient.SendMail(info.Addresses[1], []string{"recipient@pm.me"}, strings.NewReader("Subject: test\r\n\r\nhello"))
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "can't be used to send from this address")
+			}()
+		})
+	})
+}
+
+func TestBridge_AppPasswordReadOnlyFetch(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		var messageID string
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			messageID = createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 1)[0]
+			require.NoError(t, c.MarkMessagesUnread(ctx, messageID))
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			_, readOnlyPass, err := b.CreateAppPassword(userID, "monitoring", vault.AppPasswordScope{IMAP: vault.IMAPReadOnly})
+			require.NoError(t, err)
+
+			imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			defer func() { _ = imapClient.Logout() }()
+
+			require.NoError(t, imapClient.Login(info.Addresses[0], string(readOnlyPass)))
+
+			// Fetching the body without PEEK implicitly marks the message as seen, which read-only sessions may do.
+			messages, err := clientFetch(imapClient, "INBOX", "BODY[]")
+			require.NoError(t, err)
+			require.Len(t, messages, 1)
+			require.Contains(t, messages[0].Flags, imap.SeenFlag)
+
+			withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+				require.Eventually(t, func() bool {
+					message, err := c.GetMessage(ctx, messageID)
+					require.NoError(t, err)
+
+					return !bool(message.Unread)
+				}, 5*time.Second, 100*time.Millisecond)
+			})
+
+			// But they may not explicitly mark it as unseen.
+			require.Error(t, clientStore(imapClient, 1, 1, false, imap.FormatFlagsOp(imap.RemoveFlags, true), imap.SeenFlag))
+		})
+	})
+}
+
+func TestBridge_SCRAM(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			laptop, laptopPass, err := b.CreateAppPassword(userID, "laptop", vault.AppPasswordScope{})
+			require.NoError(t, err)
+
+			auth := func(useTLS bool, newClient func(binding []byte) sasl.Client) error {
+				smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+				require.NoError(t, err)
+				defer func() { _ = smtpClient.Close() }()
+
+				var binding []byte
+
+				if useTLS {
+					require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+
+					state, ok := smtpClient.TLSConnectionState()
+					require.True(t, ok)
+
+					binding, err = state.ExportKeyingMaterial(scram.ChannelBindingLabel, nil, scram.ChannelBindingLength)
+					require.NoError(t, err)
+				}
+
+				return smtpClient.Auth(newClient(binding))
+			}
+
+			// The bridge password and app passwords can be used without sending them, with or without TLS.
+			require.NoError(t, auth(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], string(info.BridgePass))
+			}))
+
+			require.NoError(t, auth(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], string(laptopPass))
+			}))
+
+			require.NoError(t, auth(true, func(binding []byte) sasl.Client {
+				return scram.NewPlusClient(info.Addresses[0], string(laptopPass), binding)
+			}))
+
+			// Channel binding requires TLS.
+			require.Error(t, auth(false, func([]byte) sasl.Client {
+				return scram.NewPlusClient(info.Addresses[0], string(laptopPass), []byte("binding"))
+			}))
+
+			// Wrong and revoked passwords can't be used.
+			require.Error(t, auth(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], "wrong password")
+			}))
+
+			require.NoError(t, b.RevokeAppPassword(userID, laptop.ID))
+
+			require.Error(t, auth(true, func(binding []byte) sasl.Client {
+				return scram.NewPlusClient(info.Addresses[0], string(laptopPass), binding)
+			}))
+		})
+	})
+}
+
+func TestBridge_IMAPSCRAM(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(b)
+			defer imapWaiter.Done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			laptop, laptopPass, err := b.CreateAppPassword(userID, "laptop", vault.AppPasswordScope{})
+			require.NoError(t, err)
+
+			_, readOnlyPass, err := b.CreateAppPassword(userID, "monitoring", vault.AppPasswordScope{IMAP: vault.IMAPReadOnly})
+			require.NoError(t, err)
+
+			_, sendOnlyPass, err := b.CreateAppPassword(userID, "alerting", vault.AppPasswordScope{IMAP: vault.IMAPDenied})
+			require.NoError(t, err)
+
+			withSCRAMClient := func(useTLS bool, newClient func(binding []byte) sasl.Client, fn func(*client.Client)) error {
+				var (
+					imapClient *client.Client
+					binding    []byte
+				)
+
+				addr := net.JoinHostPort(constants.Host, fmt.Sprint(b.GetIMAPPort()))
+
+				if useTLS {
+					conn, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true})
+					require.NoError(t, err)
+
+					state := conn.ConnectionState()
+
+					binding, err = state.ExportKeyingMaterial(scram.ChannelBindingLabel, nil, scram.ChannelBindingLength)
+					require.NoError(t, err)
+
+					imapClient, err = client.New(conn)
+					require.NoError(t, err)
+				} else {
+					imapClient, err = eventuallyDial(addr)
+					require.NoError(t, err)
+				}
+
+				defer func() { _ = imapClient.Logout() }()
+
+				if err := imapClient.Authenticate(newClient(binding)); err != nil {
+					return err
+				}
+
+				fn(imapClient)
+
+				return nil
+			}
+
+			// The bridge password and app passwords can be used without sending them.
+			require.NoError(t, withSCRAMClient(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], string(info.BridgePass))
+			}, func(imapClient *client.Client) {
+				_, err := imapClient.Select("INBOX", false)
+				require.NoError(t, err)
+			}))
+
+			require.NoError(t, withSCRAMClient(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], string(laptopPass))
+			}, func(imapClient *client.Client) {
+				require.NoError(t, imapClient.Create("Folders/laptop"))
+			}))
+
+			// The scope of the password is enforced as with LOGIN.
+			require.NoError(t, withSCRAMClient(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], string(readOnlyPass))
+			}, func(imapClient *client.Client) {
+				_, err := imapClient.Select("INBOX", false)
+				require.NoError(t, err)
+
+				require.Error(t, imapClient.Create("Folders/readonly"))
+			}))
+
+			require.Error(t, withSCRAMClient(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], string(sendOnlyPass))
+			}, func(*client.Client) {}))
+
+			// Wrong passwords can't be used.
+			require.Error(t, withSCRAMClient(false, func([]byte) sasl.Client {
+				return scram.NewClient(info.Addresses[0], "wrong password")
+			}, func(*client.Client) {}))
+
+			// Channel binding requires TLS.
+			require.Error(t, withSCRAMClient(false, func([]byte) sasl.Client {
+				return scram.NewPlusClient(info.Addresses[0], string(laptopPass), []byte("binding"))
+			}, func(*client.Client) {}))
+
+			require.NoError(t, b.SetIMAPSSL(ctx, true))
+
+			require.NoError(t, withSCRAMClient(true, func(binding []byte) sasl.Client {
+				return scram.NewPlusClient(info.Addresses[0], string(laptopPass), binding)
+			}, func(*client.Client) {}))
+
+			// Revoked passwords can't be used.
+			require.NoError(t, b.RevokeAppPassword(userID, laptop.ID))
+
+			require.Error(t, withSCRAMClient(true, func(binding []byte) sasl.Client {
+				return scram.NewPlusClient(info.Addresses[0], string(laptopPass), binding)
+			}, func(*client.Client) {}))
+		})
+	})
+}
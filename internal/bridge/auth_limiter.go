@@ -0,0 +1,337 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// authDelayThreshold is the number of failed attempts after which further attempts are delayed.
+	authDelayThreshold = 3
+
+	// authDelayBase is the delay after authDelayThreshold failed attempts; it doubles with each further failure.
+	authDelayBase = time.Second
+
+	// authDelayMax is the longest delay between two attempts, before the lockout.
+	authDelayMax = time.Minute
+
+	// authLockoutThreshold is the number of failed attempts after which no attempt is allowed for authLockoutDuration.
+	authLockoutThreshold = 10
+
+	// authLockoutDuration is how long a username is locked out from a source.
+	authLockoutDuration = 15 * time.Minute
+
+	// authFailureExpiry is how long failed attempts are remembered after the last one.
+	// Until then, a single failed attempt after a lockout locks out again.
+	authFailureExpiry = time.Hour
+)
+
+// AuthLockout is a username which is locked out from a source after too many failed authentication attempts.
+type AuthLockout struct {
+	// Source is the address from which the attempts were made.
+	Source string
+
+	// Username is the username with which the attempts were made.
+	Username string
+
+	Failures int
+	Until    time.Time
+}
+
+// authKey identifies what failed attempts are counted against: a source, a username, or a username from a source.
+type authKey struct {
+	source   string
+	username string
+}
+
+// canLockOut returns whether failed attempts counted against the key lead to a lockout, rather than just delays.
+// Only a username from a remote source can be locked out: sources and usernames alone are shared by legitimate clients,
+// as are local sources, which all clients on the host (e.g. all those connecting over a Unix socket) have in common.
+// A single misconfigured client would then lock out all the others.
+func (key authKey) canLockOut() bool {
+	return key.source != "" && key.username != "" && !isLocalAuthSource(key.source)
+}
+
+type authFailures struct {
+	count int
+	last  time.Time
+}
+
+// retryAt returns when the next attempt is allowed.
+func (f *authFailures) retryAt(canLockOut bool) time.Time {
+	switch {
+	case canLockOut && f.count >= authLockoutThreshold:
+		return f.last.Add(authLockoutDuration)
+
+	case f.count >= authDelayThreshold:
+		delay := authDelayBase << (f.count - authDelayThreshold)
+		if delay > authDelayMax {
+			delay = authDelayMax
+		}
+
+		return f.last.Add(delay)
+
+	default:
+		return f.last
+	}
+}
+
+// authLimiter throttles repeated failed attempts to authenticate over IMAP and SMTP.
+// Failures are counted per source and per username, so that guessing the password of an account from many sources,
+// or the passwords of many accounts from one source, is delayed alike.
+// They are also counted per username from each source, which is locked out after too many of them.
+type authLimiter struct {
+	failures map[authKey]*authFailures
+
+	// sessions holds the source of each IMAP session, since gluon only gives the session ID when authenticating.
+	sessions map[int]string
+
+	lock sync.Mutex
+
+	onLockout func(AuthLockout)
+
+	now func() time.Time
+}
+
+func newAuthLimiter(onLockout func(AuthLockout)) *authLimiter {
+	return &authLimiter{
+		failures:  make(map[authKey]*authFailures),
+		sessions:  make(map[int]string),
+		onLockout: onLockout,
+		now:       time.Now,
+	}
+}
+
+// allow returns whether an attempt to authenticate from the given source with the given username is allowed.
+func (l *authLimiter) allow(source, username string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+
+	for _, key := range authKeys(source, username) {
+		if f := l.getFailures(key, now); f != nil && now.Before(f.retryAt(key.canLockOut())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fail records a failed attempt to authenticate from the given source with the given username.
+// Attempts which were not allowed in the first place are not counted, so that the lockout doesn't extend forever.
+func (l *authLimiter) fail(source, username string) {
+	var lockouts []AuthLockout
+
+	func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+
+		now := l.now()
+
+		for _, key := range authKeys(source, username) {
+			f := l.getFailures(key, now)
+
+			if f == nil {
+				f = &authFailures{}
+				l.failures[key] = f
+			} else if now.Before(f.retryAt(key.canLockOut())) {
+				continue
+			}
+
+			f.count++
+			f.last = now
+
+			if key.canLockOut() && f.count >= authLockoutThreshold {
+				lockouts = append(lockouts, newAuthLockout(key, f))
+			}
+		}
+	}()
+
+	for _, lockout := range lockouts {
+		logrus.WithFields(logrus.Fields{
+			"source":   lockout.Source,
+			"username": lockout.Username,
+			"until":    lockout.Until,
+		}).Warn("Too many failed authentication attempts, locking out")
+
+		l.onLockout(lockout)
+	}
+}
+
+// succeed records a successful attempt to authenticate from the given source with the given username,
+// which forgets the failed attempts of the username, lifting its lockout from that source.
+// Those of the source are kept, so that a legitimate client can't reset the count of another process on the same host.
+func (l *authLimiter) succeed(source, username string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	username = normalizeAuthUsername(username)
+
+	delete(l.failures, authKey{username: username})
+	delete(l.failures, authKey{source: source, username: username})
+}
+
+// lockouts returns the usernames which are currently locked out, and the sources they are locked out from.
+func (l *authLimiter) lockouts() []AuthLockout {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+
+	var lockouts []AuthLockout
+
+	for key := range l.failures {
+		if f := l.getFailures(key, now); f != nil && key.canLockOut() && f.count >= authLockoutThreshold && now.Before(f.retryAt(true)) {
+			lockouts = append(lockouts, newAuthLockout(key, f))
+		}
+	}
+
+	sort.Slice(lockouts, func(i, j int) bool {
+		return lockouts[i].Until.Before(lockouts[j].Until)
+	})
+
+	return lockouts
+}
+
+// clear forgets all failed attempts, lifting all lockouts.
+func (l *authLimiter) clear() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.failures = make(map[authKey]*authFailures)
+}
+
+// getFailures returns the failed attempts counted against the given key, forgetting them if they have expired.
+func (l *authLimiter) getFailures(key authKey, now time.Time) *authFailures {
+	f, ok := l.failures[key]
+	if !ok {
+		return nil
+	}
+
+	if now.Sub(f.last) > authFailureExpiry && !now.Before(f.retryAt(key.canLockOut())) {
+		delete(l.failures, key)
+		return nil
+	}
+
+	return f
+}
+
+func (l *authLimiter) addIMAPSession(sessionID int, remoteAddr net.Addr) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.sessions[sessionID] = getAuthSource(remoteAddr)
+}
+
+func (l *authLimiter) removeIMAPSession(sessionID int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.sessions, sessionID)
+}
+
+func (l *authLimiter) getIMAPSessionSource(sessionID int) string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.sessions[sessionID]
+}
+
+// AllowIMAPAuth returns whether the given IMAP session may attempt to authenticate with the given username.
+func (l *authLimiter) AllowIMAPAuth(sessionID int, username string) bool {
+	return l.allow(l.getIMAPSessionSource(sessionID), username)
+}
+
+// IMAPAuthSucceeded records that the given IMAP session has authenticated with the given username.
+func (l *authLimiter) IMAPAuthSucceeded(sessionID int, username string) {
+	l.succeed(l.getIMAPSessionSource(sessionID), username)
+}
+
+// GetAuthLockouts returns the usernames which are locked out after too many failed authentication attempts,
+// and the sources they are locked out from.
+func (bridge *Bridge) GetAuthLockouts() []AuthLockout {
+	return bridge.authLimiter.lockouts()
+}
+
+// ClearAuthLockouts lifts all lockouts and forgets all failed authentication attempts.
+func (bridge *Bridge) ClearAuthLockouts() {
+	logrus.Info("Clearing authentication lockouts")
+
+	bridge.authLimiter.clear()
+}
+
+func newAuthLockout(key authKey, f *authFailures) AuthLockout {
+	return AuthLockout{
+		Source:   key.source,
+		Username: key.username,
+		Failures: f.count,
+		Until:    f.retryAt(true),
+	}
+}
+
+// authKeys returns the keys against which an attempt from the given source with the given username is counted.
+// The source may be unknown, e.g. if the IMAP session has just been opened.
+// Local sources are not counted alone: the username from the source already delays a misbehaving local client.
+func authKeys(source, username string) []authKey {
+	username = normalizeAuthUsername(username)
+
+	keys := []authKey{{username: username}}
+
+	if source != "" {
+		keys = append(keys, authKey{source: source, username: username})
+
+		if !isLocalAuthSource(source) {
+			keys = append(keys, authKey{source: source})
+		}
+	}
+
+	return keys
+}
+
+// isLocalAuthSource returns whether the given source is on this host: a loopback address or a Unix socket.
+func isLocalAuthSource(source string) bool {
+	ip := net.ParseIP(source)
+
+	return ip == nil || ip.IsLoopback()
+}
+
+func normalizeAuthUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// getAuthSource returns the source of a connection from its remote address: the IP address without the port.
+// All connections over a Unix socket share the same source.
+func getAuthSource(addr net.Addr) string {
+	if addr == nil {
+		return ""
+	}
+
+	if host, _, err := net.SplitHostPort(addr.String()); err == nil {
+		return host
+	}
+
+	return addr.Network()
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthLimiter(t *testing.T) {
+	var lockouts []AuthLockout
+
+	limiter := newAuthLimiter(func(lockout AuthLockout) {
+		lockouts = append(lockouts, lockout)
+	})
+
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	// The first few failures are not delayed.
+	for i := 0; i < authDelayThreshold; i++ {
+		require.True(t, limiter.allow("1.2.3.4", "user@pm.me"))
+		limiter.fail("1.2.3.4", "user@pm.me")
+	}
+
+	// Then further attempts are delayed, from the same source or with the same username.
+	require.False(t, limiter.allow("1.2.3.4", "other@pm.me"))
+	require.False(t, limiter.allow("5.6.7.8", "User@pm.me"))
+	require.True(t, limiter.allow("5.6.7.8", "other@pm.me"))
+
+	// Attempts made while delayed are not counted.
+	limiter.fail("1.2.3.4", "user@pm.me")
+	now = now.Add(authDelayBase)
+	require.True(t, limiter.allow("1.2.3.4", "user@pm.me"))
+
+	// The delay doubles with each failure until the lockout.
+	for i := authDelayThreshold; i < authLockoutThreshold; i++ {
+		require.True(t, limiter.allow("1.2.3.4", "user@pm.me"))
+		limiter.fail("1.2.3.4", "user@pm.me")
+		require.False(t, limiter.allow("1.2.3.4", "user@pm.me"))
+
+		if i < authLockoutThreshold-1 {
+			now = now.Add(authDelayMax)
+		}
+	}
+
+	// Only the username is locked out from the source; the source and the username alone are just delayed.
+	require.Equal(t, []AuthLockout{
+		{Source: "1.2.3.4", Username: "user@pm.me", Failures: authLockoutThreshold, Until: now.Add(authLockoutDuration)},
+	}, lockouts)
+	require.Equal(t, lockouts, limiter.lockouts())
+
+	now = now.Add(authDelayMax)
+	require.False(t, limiter.allow("1.2.3.4", "user@pm.me"))
+	require.True(t, limiter.allow("1.2.3.4", "other@pm.me"))
+	require.True(t, limiter.allow("5.6.7.8", "user@pm.me"))
+
+	// A successful attempt lifts the lockout.
+	limiter.succeed("1.2.3.4", "User@pm.me")
+	require.True(t, limiter.allow("1.2.3.4", "user@pm.me"))
+	require.Empty(t, limiter.lockouts())
+
+	// The lockout ends after a while.
+	for i := 0; i < authLockoutThreshold; i++ {
+		limiter.fail("5.6.7.8", "other@pm.me")
+		now = now.Add(authDelayMax)
+	}
+
+	require.Len(t, lockouts, 2)
+	require.False(t, limiter.allow("5.6.7.8", "other@pm.me"))
+	now = now.Add(authLockoutDuration)
+	require.True(t, limiter.allow("5.6.7.8", "other@pm.me"))
+	require.Empty(t, limiter.lockouts())
+
+	// Until the failures expire, a single failure after the lockout locks out again.
+	limiter.fail("5.6.7.8", "other@pm.me")
+	require.Len(t, lockouts, 3)
+	require.False(t, limiter.allow("5.6.7.8", "other@pm.me"))
+	require.True(t, limiter.allow("1.2.3.4", "third@pm.me"))
+
+	// Lockouts can be cleared.
+	require.Len(t, limiter.lockouts(), 1)
+	limiter.clear()
+	require.Empty(t, limiter.lockouts())
+	require.True(t, limiter.allow("5.6.7.8", "other@pm.me"))
+}
+
+func TestAuthLimiter_Local(t *testing.T) {
+	var lockouts []AuthLockout
+
+	limiter := newAuthLimiter(func(lockout AuthLockout) {
+		lockouts = append(lockouts, lockout)
+	})
+
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	// A misconfigured local client keeps failing.
+	for _, source := range []string{"127.0.0.1", "::1", "unix"} {
+		for i := 0; i < 2*authLockoutThreshold; i++ {
+			limiter.fail(source, "user@pm.me")
+			now = now.Add(authDelayMax)
+		}
+
+		// It is delayed, but never locked out.
+		require.True(t, limiter.allow(source, "user@pm.me"))
+		limiter.fail(source, "user@pm.me")
+		require.False(t, limiter.allow(source, "user@pm.me"))
+
+		// Other local clients are not delayed.
+		require.True(t, limiter.allow(source, "other@pm.me"))
+	}
+
+	require.Empty(t, lockouts)
+	require.Empty(t, limiter.lockouts())
+
+	// A successful attempt forgets the failures.
+	limiter.succeed("unix", "user@pm.me")
+	require.True(t, limiter.allow("unix", "user@pm.me"))
+}
+
+func TestAuthLimiter_IMAPSessions(t *testing.T) {
+	limiter := newAuthLimiter(func(AuthLockout) {})
+
+	limiter.addIMAPSession(1, &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 1234})
+	limiter.addIMAPSession(2, &net.UnixAddr{Net: "unix"})
+	require.Equal(t, "2001:db8::1", limiter.getIMAPSessionSource(1))
+	require.Equal(t, "unix", limiter.getIMAPSessionSource(2))
+
+	for i := 0; i < authDelayThreshold; i++ {
+		limiter.fail(limiter.getIMAPSessionSource(1), "user@pm.me")
+	}
+
+	require.False(t, limiter.AllowIMAPAuth(1, "other@pm.me"))
+	require.True(t, limiter.AllowIMAPAuth(2, "other@pm.me"))
+
+	limiter.removeIMAPSession(1)
+	require.Empty(t, limiter.getIMAPSessionSource(1))
+}
@@ -21,10 +21,15 @@ package bridge
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -48,6 +53,12 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// The names of the Unix sockets the servers listen on in the runtime directory, if enabled.
+const (
+	imapSocketName = "imap.sock"
+	smtpSocketName = "smtp.sock"
+)
+
 type Bridge struct {
 	// vault holds bridge-specific data, such as preferences and known users (authorized or not).
 	vault *vault.Vault
@@ -64,6 +75,10 @@ type Bridge struct {
 	// tlsConfig holds the bridge TLS config used by the IMAP and SMTP servers.
 	tlsConfig *tls.Config
 
+	// tlsCert is the certificate served by the IMAP and SMTP servers; it can be replaced while they run.
+	tlsCert     *tls.Certificate
+	tlsCertLock safe.RWMutex
+
 	// imapServer is the bridge's IMAP server.
 	imapEventCh chan imapEvents.Event
 
@@ -125,9 +140,18 @@ type Bridge struct {
 	// goHeartbeat triggers a check/sending if heartbeat is needed.
 	goHeartbeat func()
 
+	// goCheckTLSCert triggers a reload of the TLS certificate if its files changed and a check of its expiry.
+	goCheckTLSCert func()
+
 	uidValidityGenerator imap.UIDValidityGenerator
 
 	serverManager *ServerManager
+
+	// authLimiter throttles repeated failed attempts to authenticate over IMAP and SMTP.
+	authLimiter *authLimiter
+
+	// tlsPeers records the certificates presented by TLS clients, to match them against those mapped to the users.
+	tlsPeers *tlsPeers
 }
 
 // New creates a new bridge.
@@ -213,11 +237,17 @@ func newBridge(
 
 	logIMAPClient, logIMAPServer, logSMTP bool,
 ) (*Bridge, error) {
-	tlsConfig, err := loadTLSConfig(vault)
+	tlsCert, err := loadTLSCert(vault)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load TLS config: %w", err)
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	if err := initClientCA(vault); err != nil {
+		return nil, fmt.Errorf("failed to initialize client CA: %w", err)
 	}
 
+	recoverGluonRekeys(vault)
+
 	firstStart := vault.GetFirstStart()
 	if err := vault.SetFirstStart(false); err != nil {
 		return nil, fmt.Errorf("failed to save first start indicator: %w", err)
@@ -245,7 +275,9 @@ func newBridge(
 		proxyCtl:   proxyCtl,
 		identifier: identifier,
 
-		tlsConfig:   tlsConfig,
+		tlsConfig:   &tls.Config{MinVersion: tls.VersionTLS12},
+		tlsCert:     tlsCert,
+		tlsCertLock: safe.NewRWMutex(),
 		imapEventCh: imapEventCh,
 
 		updater:   updater,
@@ -274,8 +306,23 @@ func newBridge(
 		uidValidityGenerator: uidValidityGenerator,
 
 		serverManager: newServerManager(),
+
+		tlsPeers: newTLSPeers(),
 	}
 
+	// The certificate, and whether clients are asked for one, are decided for each connection, as both can change at any time.
+	bridge.tlsConfig.GetCertificate = bridge.getTLSCert
+	bridge.tlsConfig.GetConfigForClient = bridge.getClientTLSConfig
+
+	bridge.authLimiter = newAuthLimiter(func(lockout AuthLockout) {
+		bridge.publish(events.AuthLockout{
+			Source:   lockout.Source,
+			Username: lockout.Username,
+			Failures: lockout.Failures,
+			Until:    lockout.Until,
+		})
+	})
+
 	if err := bridge.serverManager.Init(bridge); err != nil {
 		return nil, err
 	}
@@ -335,6 +382,10 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 		})
 	})
 
+	// Reload the TLS certificate when its files change and warn when it is about to expire.
+	bridge.goCheckTLSCert = bridge.tasks.PeriodicOrTrigger(TLSCertCheckPeriod, 0, bridge.newTLSCertChecker())
+	defer bridge.goCheckTLSCert()
+
 	// Publish a raise event if the focus service is called.
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, bridge.focusService.GetRaiseCh(), func(struct{}) {
@@ -515,34 +566,120 @@ func (bridge *Bridge) onStatusDown(ctx context.Context) {
 	}
 }
 
-func loadTLSConfig(vault *vault.Vault) (*tls.Config, error) {
+func loadTLSCert(vault *vault.Vault) (*tls.Certificate, error) {
 	cert, err := tls.X509KeyPair(vault.GetBridgeTLSCert())
 	if err != nil {
 		return nil, err
 	}
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
 }
 
-func newListener(port int, useTLS bool, tlsConfig *tls.Config) (net.Listener, error) {
+// newListener creates a TCP listener on the given address, whose connections are tracked by the given TLS peers.
+func newListener(address string, useTLS bool, tlsConfig *tls.Config, peers *tlsPeers) (net.Listener, error) {
+	netListener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
 	if useTLS {
-		tlsListener, err := tls.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port), tlsConfig)
-		if err != nil {
-			return nil, err
-		}
+		return tls.NewListener(peers.listen(netListener), tlsConfig), nil
+	}
 
-		return tlsListener, nil
+	return peers.listen(netListener), nil
+}
+
+// getSocketPath returns the path of the Unix socket with the given name in the runtime directory,
+// or an empty string if the servers shouldn't listen on Unix sockets.
+func (bridge *Bridge) getSocketPath(name string) (string, error) {
+	if !bridge.vault.GetUnixSockets() {
+		return "", nil
 	}
 
-	netListener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port))
+	runtimeDir, err := bridge.locator.ProvideRuntimePath()
 	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(runtimeDir, name), nil
+}
+
+// newUnixListener creates a listener on a Unix socket at the given path which only the current user can connect to.
+// A socket left behind by a previous run is removed first.
+func newUnixListener(path string, peers *tlsPeers) (net.Listener, error) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
 		return nil, err
 	}
 
-	return netListener, nil
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The runtime directory is only accessible to the user already; restrict the socket itself too.
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return peers.listen(listener), nil
+}
+
+// newListeners creates the listener on the given port on localhost, followed by the listeners of the given specs
+// and, if socketPath is not empty, a listener on the Unix socket at that path.
+// If any of them can't be created, those already created are closed.
+func newListeners(port int, useTLS bool, specs []vault.ListenerSpec, socketPath string, tlsConfig *tls.Config, peers *tlsPeers) ([]net.Listener, error) {
+	listener, err := newListener(net.JoinHostPort(constants.Host, strconv.Itoa(port)), useTLS, tlsConfig, peers)
+	if err != nil {
+		return nil, err
+	}
+
+	listeners := []net.Listener{listener}
+
+	for _, spec := range specs {
+		listener, err := newListener(spec.Address, spec.SSL, tlsConfig, peers)
+		if err != nil {
+			if closeErr := closeListeners(listeners); closeErr != nil {
+				logrus.WithError(closeErr).Warn("Failed to close listeners")
+			}
+
+			return nil, fmt.Errorf("failed to listen on %v: %w", spec, err)
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	if socketPath != "" {
+		listener, err := newUnixListener(socketPath, peers)
+		if err != nil {
+			if closeErr := closeListeners(listeners); closeErr != nil {
+				logrus.WithError(closeErr).Warn("Failed to close listeners")
+			}
+
+			return nil, fmt.Errorf("failed to listen on %v: %w", socketPath, err)
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return listeners, nil
+}
+
+// closeListeners closes all the given listeners, returning the first error encountered.
+func closeListeners(listeners []net.Listener) error {
+	var closeErr error
+
+	for _, listener := range listeners {
+		if err := listener.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
 }
 
 func min(a, b time.Duration) time.Duration {
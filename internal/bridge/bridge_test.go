@@ -67,6 +67,7 @@ var (
 func init() {
 	user.EventPeriod = 100 * time.Millisecond
 	user.EventJitter = 0
+	bridge.TLSCertCheckPeriod = 100 * time.Millisecond
 	backend.GenerateKey = backend.FastGenerateKey
 	certs.GenerateCert = tests.FastGenerateCert
 }
@@ -736,6 +737,54 @@ func TestBridge_LoginFailed(t *testing.T) {
 	})
 }
 
+func TestBridge_LoginThrottled(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(b)
+			defer imapWaiter.Done()
+
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+			smtpWaiter.Wait()
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			imapClient, err := eventuallyDial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetIMAPPort())))
+			require.NoError(t, err)
+			defer func() { _ = imapClient.Logout() }()
+
+			smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+			require.NoError(t, err)
+			defer func() { _ = smtpClient.Close() }()
+
+			require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+
+			// After a few failed attempts, further attempts are delayed, even with the right password.
+			for i := 0; i < 3; i++ {
+				require.Error(t, smtpClient.Auth(sasl.NewPlainClient("", info.Addresses[0], "badPass")))
+			}
+
+			smtpErr := new(smtp.SMTPError)
+			require.ErrorAs(t, smtpClient.Auth(sasl.NewPlainClient("", info.Addresses[0], string(info.BridgePass))), &smtpErr)
+			require.Equal(t, 454, smtpErr.Code)
+
+			// The delay applies to all attempts with the same username, over IMAP too.
+			require.Error(t, imapClient.Login(info.Addresses[0], string(info.BridgePass)))
+
+			// Once the failed attempts are forgotten, the right password is accepted again.
+			b.ClearAuthLockouts()
+
+			require.NoError(t, imapClient.Login(info.Addresses[0], string(info.BridgePass)))
+		})
+	})
+}
+
 func TestBridge_ChangeCacheDirectory(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
 		userID, addrID, err := s.CreateUser("imap", password)
@@ -931,6 +980,7 @@ func withBridgeNoMocks(
 	// Create the vault.
 	vault, _, err := vault.New(vaultDir, t.TempDir(), vaultKey, async.NoopPanicHandler{})
 	require.NoError(t, err)
+	defer func() { require.NoError(t, vault.Flush()) }()
 
 	// Create a new cookie jar.
 	cookieJar, err := cookies.NewCookieJar(bridge.NewTestCookieJar(), vault)
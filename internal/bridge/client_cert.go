@@ -0,0 +1,328 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+)
+
+// clientCertPasswordLength is the length, in bytes, of the random passwords protecting enrolled client certificates.
+const clientCertPasswordLength = 16
+
+// ClientCertInfo describes a TLS client certificate mapped to a user.
+type ClientCertInfo struct {
+	// ID is the ID of the mapping.
+	ID string
+
+	// Name is the name given to the certificate, usually that of the device using it.
+	Name string
+
+	// Fingerprint is the hex-encoded SHA-256 hash of the certificate, if it is matched by fingerprint.
+	Fingerprint string
+
+	// Subject is the distinguished name of the certificate, if it is matched by subject.
+	Subject string
+
+	// SkipPassword is whether clients presenting the certificate can authenticate without a password.
+	// This is only possible over SMTP (SASL EXTERNAL): gluon doesn't implement AUTHENTICATE,
+	// so IMAP clients still log in with a password.
+	SkipPassword bool
+
+	// CreatedAt is the time at which the certificate was mapped to the user.
+	CreatedAt time.Time
+}
+
+func (bridge *Bridge) GetClientCertMode() vault.ClientCertMode {
+	return bridge.vault.GetClientCertMode()
+}
+
+// SetClientCertMode sets whether IMAP and SMTP clients are asked for a TLS client certificate.
+// It applies to the next TLS handshakes, so the servers don't need to be restarted.
+func (bridge *Bridge) SetClientCertMode(mode vault.ClientCertMode) error {
+	return bridge.vault.SetClientCertMode(mode)
+}
+
+// GetClientCerts returns the client certificates mapped to the given user, oldest first.
+func (bridge *Bridge) GetClientCerts(userID string) ([]ClientCertInfo, error) {
+	var clientCerts []vault.ClientCert
+
+	if err := bridge.withVaultUser(userID, func(user *vault.User) error {
+		clientCerts = user.GetClientCerts()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return xslices.Map(clientCerts, newClientCertInfo), nil
+}
+
+// AddClientCert maps a client certificate, given by its fingerprint or its subject, to the given user.
+// Certificates matched by subject must have been issued by the client CA.
+func (bridge *Bridge) AddClientCert(userID, name, fingerprint, subject string, skipPassword bool) (ClientCertInfo, error) {
+	var clientCert vault.ClientCert
+
+	if err := bridge.withVaultUser(userID, func(user *vault.User) error {
+		var err error
+
+		clientCert, err = user.AddClientCert(name, fingerprint, subject, skipPassword)
+
+		return err
+	}); err != nil {
+		return ClientCertInfo{}, err
+	}
+
+	return newClientCertInfo(clientCert), nil
+}
+
+// GetClientCA returns the PEM-encoded certificate and key of the CA issuing the client certificates.
+// Unlike the TLS certificate, it is kept when the latter is regenerated.
+func (bridge *Bridge) GetClientCA() ([]byte, []byte) {
+	return bridge.vault.GetClientCA()
+}
+
+// EnrollClientCert issues a client certificate with the given name, signed by the client CA,
+// and maps it to the given user by its fingerprint.
+// It returns the certificate and its private key as a PKCS#12 file, along with the random password protecting it;
+// neither can be retrieved afterwards.
+func (bridge *Bridge) EnrollClientCert(userID, name string, skipPassword bool) (ClientCertInfo, []byte, []byte, error) {
+	caCertPEM, caKeyPEM := bridge.vault.GetClientCA()
+
+	cert, key, err := certs.NewClientCert(caCertPEM, caKeyPEM, name)
+	if err != nil {
+		return ClientCertInfo{}, nil, nil, err
+	}
+
+	caCert, err := parseCertPEM(caCertPEM)
+	if err != nil {
+		return ClientCertInfo{}, nil, nil, err
+	}
+
+	password := make([]byte, clientCertPasswordLength)
+
+	if _, err := rand.Read(password); err != nil {
+		return ClientCertInfo{}, nil, nil, err
+	}
+
+	password = algo.B64RawEncode(password)
+
+	pkcs12, err := certs.EncodePKCS12(key, []*x509.Certificate{cert, caCert}, string(password))
+	if err != nil {
+		return ClientCertInfo{}, nil, nil, err
+	}
+
+	info, err := bridge.AddClientCert(userID, name, certs.GetFingerprint(cert), "", skipPassword)
+	if err != nil {
+		return ClientCertInfo{}, nil, nil, err
+	}
+
+	return info, pkcs12, password, nil
+}
+
+// RemoveClientCert removes the client certificate with the given ID from the given user.
+func (bridge *Bridge) RemoveClientCert(userID, clientCertID string) error {
+	return bridge.withVaultUser(userID, func(user *vault.User) error {
+		return user.RemoveClientCert(clientCertID)
+	})
+}
+
+// getClientTLSConfig returns the TLS config of a connection, which asks the client for a certificate
+// unless client certificates are off. Certificates issued by anyone are accepted during the handshake,
+// to be matched against those mapped to the users when the client authenticates.
+func (bridge *Bridge) getClientTLSConfig(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	if bridge.vault.GetClientCertMode() == vault.ClientCertsOff {
+		return nil, nil //nolint:nilnil
+	}
+
+	conn := hello.Conn
+
+	config := bridge.tlsConfig.Clone()
+
+	config.GetConfigForClient = nil
+	config.ClientAuth = tls.RequestClientCert
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) > 0 {
+			bridge.tlsPeers.setCert(conn, state.PeerCertificates[0])
+		}
+
+		return nil
+	}
+
+	return config, nil
+}
+
+// findClientCert returns the ID of the user to which the given client certificate is mapped, along with the mapping.
+func (bridge *Bridge) findClientCert(cert *x509.Certificate) (string, vault.ClientCert, bool) {
+	caCertPEM, _ := bridge.vault.GetClientCA()
+
+	var (
+		fingerprint = certs.GetFingerprint(cert)
+		subject     = cert.Subject.String()
+		trusted     = certs.VerifyClientCert(caCertPEM, cert)
+	)
+
+	for _, userID := range bridge.vault.GetUserIDs() {
+		var (
+			clientCert vault.ClientCert
+			found      bool
+		)
+
+		if err := bridge.vault.GetUser(userID, func(user *vault.User) {
+			idx := xslices.IndexFunc(user.GetClientCerts(), func(clientCert vault.ClientCert) bool {
+				return clientCert.Matches(fingerprint, subject, trusted)
+			})
+
+			if idx >= 0 {
+				clientCert, found = user.GetClientCerts()[idx], true
+			}
+		}); err != nil {
+			continue
+		}
+
+		if found {
+			return userID, clientCert, true
+		}
+	}
+
+	return "", vault.ClientCert{}, false
+}
+
+// allowClientCert returns whether a client which connected from the given address and presented the given certificate,
+// if any, may authenticate as the given user, or as any user if empty, with a password.
+// When client certificates are required, the certificate must be mapped to the same user;
+// connections over Unix sockets are exempt, as only the user can open them.
+func (bridge *Bridge) allowClientCert(remoteAddr net.Addr, cert *x509.Certificate, userID string) bool {
+	if bridge.vault.GetClientCertMode() != vault.ClientCertsRequired {
+		return true
+	}
+
+	if remoteAddr != nil && remoteAddr.Network() == "unix" {
+		return true
+	}
+
+	if cert == nil {
+		return false
+	}
+
+	certUserID, _, ok := bridge.findClientCert(cert)
+
+	return ok && (userID == "" || certUserID == userID)
+}
+
+// imapAuthChecker decides whether IMAP sessions may attempt to authenticate.
+type imapAuthChecker struct {
+	*Bridge
+}
+
+// AllowIMAPAuth returns whether the given IMAP session may attempt to authenticate as the given user with the given username,
+// which it can't while locked out or, when client certificates are required, without one mapped to the user.
+func (checker imapAuthChecker) AllowIMAPAuth(sessionID int, userID, username string) bool {
+	if !checker.authLimiter.AllowIMAPAuth(sessionID, username) {
+		return false
+	}
+
+	remoteAddr, cert := checker.tlsPeers.getIMAPSession(sessionID)
+
+	return checker.allowClientCert(remoteAddr, cert, userID)
+}
+
+// IMAPAuthSucceeded records that the given IMAP session has authenticated with the given username.
+func (checker imapAuthChecker) IMAPAuthSucceeded(sessionID int, username string) {
+	checker.authLimiter.IMAPAuthSucceeded(sessionID, username)
+}
+
+// initClientCA makes sure the vault holds a CA issuing the client certificates.
+// Client certificates used to be issued by the TLS certificate: if some are mapped by subject,
+// it is adopted as the client CA so that they remain trusted; otherwise a new CA is generated.
+func initClientCA(encVault *vault.Vault) error {
+	if caCertPEM, _ := encVault.GetClientCA(); len(caCertPEM) > 0 {
+		return nil
+	}
+
+	if hasSubjectClientCerts(encVault) {
+		certPEM, keyPEM := encVault.GetBridgeTLSCert()
+
+		if cert, err := parseCertPEM(certPEM); err == nil && cert.IsCA && cert.KeyUsage&x509.KeyUsageCertSign != 0 {
+			logrus.Info("Adopting the TLS certificate as the client CA")
+
+			return encVault.SetClientCA(certPEM, keyPEM)
+		}
+	}
+
+	template, err := certs.NewClientCATemplate()
+	if err != nil {
+		return err
+	}
+
+	certPEM, keyPEM, err := certs.GenerateCertWithKeyType(template, certs.KeyTypeECDSA)
+	if err != nil {
+		return fmt.Errorf("failed to generate client CA: %w", err)
+	}
+
+	return encVault.SetClientCA(certPEM, keyPEM)
+}
+
+// hasSubjectClientCerts returns whether any user has client certificates mapped by subject.
+func hasSubjectClientCerts(encVault *vault.Vault) bool {
+	for _, userID := range encVault.GetUserIDs() {
+		var found bool
+
+		if err := encVault.GetUser(userID, func(user *vault.User) {
+			found = xslices.Any(user.GetClientCerts(), func(clientCert vault.ClientCert) bool {
+				return clientCert.Subject != ""
+			})
+		}); err == nil && found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseCertPEM parses the first certificate of the given PEM data.
+func parseCertPEM(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode certificate")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func newClientCertInfo(clientCert vault.ClientCert) ClientCertInfo {
+	return ClientCertInfo{
+		ID:           clientCert.ID,
+		Name:         clientCert.Name,
+		Fingerprint:  clientCert.Fingerprint,
+		Subject:      clientCert.Subject,
+		SkipPassword: clientCert.SkipPassword,
+		CreatedAt:    clientCert.CreatedAt,
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ClientCerts(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(b)
+			defer imapWaiter.Done()
+
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+			smtpWaiter.Wait()
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// Enroll a client certificate issued by the bridge.
+			enrolled, pkcs12, pkcs12Pass, err := b.EnrollClientCert(userID, "laptop", true)
+			require.NoError(t, err)
+			require.NotEmpty(t, pkcs12)
+			require.NotEmpty(t, pkcs12Pass)
+			require.NotEmpty(t, enrolled.Fingerprint)
+
+			// Map a certificate issued by someone else by its fingerprint.
+			clientCert, fingerprint := newClientCert(t)
+
+			desktop, err := b.AddClientCert(userID, "desktop", fingerprint, "", true)
+			require.NoError(t, err)
+
+			clientCerts, err := b.GetClientCerts(userID)
+			require.NoError(t, err)
+			require.Equal(t, []string{"laptop", "desktop"}, []string{clientCerts[0].Name, clientCerts[1].Name})
+
+			smtpAuth := func(cert *tls.Certificate, client sasl.Client) error {
+				smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+				require.NoError(t, err)
+				defer func() { _ = smtpClient.Close() }()
+
+				tlsConfig := &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+
+				if cert != nil {
+					tlsConfig.Certificates = []tls.Certificate{*cert}
+				}
+
+				require.NoError(t, smtpClient.StartTLS(tlsConfig))
+
+				return smtpClient.Auth(client)
+			}
+
+			imapLogin := func(cert *tls.Certificate) error {
+				imapClient, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				defer func() { _ = imapClient.Logout() }()
+
+				if cert != nil {
+					require.NoError(t, imapClient.StartTLS(&tls.Config{ //nolint:gosec
+						InsecureSkipVerify: true,
+						Certificates:       []tls.Certificate{*cert},
+					}))
+				}
+
+				return imapClient.Login(info.Addresses[0], string(info.BridgePass))
+			}
+
+			external := &externalClient{}
+			plain := sasl.NewPlainClient("", info.Addresses[0], string(info.BridgePass))
+
+			// By default, clients aren't asked for a certificate, so it can't be used to authenticate.
+			require.Equal(t, vault.ClientCertsOff, b.GetClientCertMode())
+			require.Error(t, smtpAuth(&clientCert, external))
+
+			// Once they are, the certificate can be used instead of a password, for any address of the user.
+			require.NoError(t, b.SetClientCertMode(vault.ClientCertsOptional))
+			require.NoError(t, smtpAuth(&clientCert, external))
+			require.NoError(t, smtpAuth(&clientCert, &externalClient{identity: info.Addresses[0]}))
+			require.Error(t, smtpAuth(&clientCert, &externalClient{identity: "other@pm.me"}))
+			require.Error(t, smtpAuth(nil, external))
+
+			// Passwords can still be used without a certificate.
+			require.NoError(t, smtpAuth(nil, plain))
+			require.NoError(t, imapLogin(nil))
+
+			// Once certificates are required, passwords can only be used along with one.
+			require.NoError(t, b.SetClientCertMode(vault.ClientCertsRequired))
+			require.Error(t, smtpAuth(nil, plain))
+			require.NoError(t, smtpAuth(&clientCert, plain))
+			require.Error(t, imapLogin(nil))
+			require.NoError(t, imapLogin(&clientCert))
+
+			// Certificates issued by the client CA can be matched by subject; they remain trusted
+			// when the TLS certificate is regenerated, unlike those issued by the TLS certificate.
+			caCertPEM, caKeyPEM := b.GetClientCA()
+
+			tabletCert, tabletKey, err := certs.NewClientCert(caCertPEM, caKeyPEM, "tablet")
+			require.NoError(t, err)
+
+			tlsCertPEM, tlsKeyPEM := b.GetBridgeTLSCert()
+
+			otherCert, otherKey, err := certs.NewClientCert(tlsCertPEM, tlsKeyPEM, "phone")
+			require.NoError(t, err)
+
+			_, err = b.AddClientCert(userID, "tablet", "", tabletCert.Subject.String(), true)
+			require.NoError(t, err)
+
+			_, err = b.AddClientCert(userID, "phone", "", otherCert.Subject.String(), true)
+			require.NoError(t, err)
+
+			tablet := tls.Certificate{Certificate: [][]byte{tabletCert.Raw}, PrivateKey: tabletKey}
+			phone := tls.Certificate{Certificate: [][]byte{otherCert.Raw}, PrivateKey: otherKey}
+
+			require.NoError(t, smtpAuth(&tablet, external))
+			require.Error(t, smtpAuth(&phone, external))
+
+			require.NoError(t, b.RegenerateTLSCert([]string{constants.Host}, certs.KeyTypeECDSA))
+			require.NoError(t, smtpAuth(&tablet, external))
+
+			// Certificates which are no longer mapped to the user can't be used.
+			require.NoError(t, b.RemoveClientCert(userID, desktop.ID))
+			require.Error(t, smtpAuth(&clientCert, external))
+			require.Error(t, smtpAuth(&clientCert, plain))
+			require.Error(t, imapLogin(&clientCert))
+		})
+	})
+}
+
+// newClientCert returns a self-signed client certificate along with its fingerprint.
+func newClientCert(t *testing.T) (tls.Certificate, string) {
+	template, err := certs.NewTLSTemplate()
+	require.NoError(t, err)
+
+	certPEM, keyPEM, err := certs.GenerateCert(template)
+	require.NoError(t, err)
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+
+	return cert, certs.GetFingerprint(leaf)
+}
+
+// externalClient is a SASL EXTERNAL client which, unlike the one of go-sasl, sends an empty identity
+// in response to the server's challenge, as the SMTP client can't send it as an empty initial response.
+type externalClient struct {
+	identity string
+}
+
+func (c *externalClient) Start() (string, []byte, error) {
+	if c.identity == "" {
+		return sasl.External, nil, nil
+	}
+
+	return sasl.External, []byte(c.identity), nil
+}
+
+func (c *externalClient) Next([]byte) ([]byte, error) {
+	return []byte{}, nil
+}
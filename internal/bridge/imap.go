@@ -20,6 +20,8 @@ package bridge
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -28,6 +30,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon"
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/db"
 	imapEvents "github.com/ProtonMail/gluon/events"
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/gluon/reporter"
@@ -36,8 +39,12 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
 	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/emersion/go-sasl"
 	"github.com/sirupsen/logrus"
 )
 
@@ -85,11 +92,145 @@ func (bridge *Bridge) handleIMAPEvent(event imapEvents.Event) {
 		}).Error("Incorrect login credentials.")
 		bridge.publish(events.IMAPLoginFailed{Username: event.Username})
 
+		bridge.authLimiter.fail(bridge.authLimiter.getIMAPSessionSource(event.SessionID), event.Username)
+
 	case imapEvents.Login:
 		if strings.Contains(bridge.GetCurrentUserAgent(), useragent.DefaultUserAgent) {
 			bridge.setUserAgent(useragent.UnknownClient, useragent.DefaultVersion)
 		}
+
+	case imapEvents.SessionAdded:
+		bridge.authLimiter.addIMAPSession(event.SessionID, event.RemoteAddr)
+		bridge.tlsPeers.addIMAPSession(event.SessionID, event.Conn)
+
+	case imapEvents.SessionRemoved:
+		bridge.authLimiter.removeIMAPSession(event.SessionID)
+		bridge.tlsPeers.removeIMAPSession(event.SessionID)
+
+		safe.RLock(func() {
+			for _, user := range bridge.users {
+				user.RemoveIMAPSession(event.SessionID)
+			}
+		}, bridge.usersLock)
+	}
+}
+
+// errIMAPAuthNotAllowed is returned when an IMAP session may not attempt to authenticate,
+// e.g. while locked out after too many failed attempts.
+var errIMAPAuthNotAllowed = errors.New("authentication not allowed")
+
+// imapSCRAMServer authenticates an IMAP session with SCRAM-SHA-256 or SCRAM-SHA-256-PLUS.
+// Gluon doesn't know the username of failed attempts, so they are reported here rather than with a LoginFailed event.
+type imapSCRAMServer struct {
+	sasl.Server
+
+	bridge *Bridge
+
+	ctx       context.Context
+	sessionID int
+
+	username   string
+	userID     string
+	appPassIDs []string
+	gluonID    string
+}
+
+// newIMAPSCRAMServer returns a SASL server for SCRAM-SHA-256, or SCRAM-SHA-256-PLUS if plus is set,
+// for the IMAP session of the given context whose connection has the given TLS state.
+func (bridge *Bridge) newIMAPSCRAMServer(ctx context.Context, tlsState *tls.ConnectionState, plus bool) imap.SASLServer {
+	sessionID, _ := imap.GetSessionIDFromContext(ctx)
+
+	server := &imapSCRAMServer{bridge: bridge, ctx: ctx, sessionID: sessionID}
+
+	if plus {
+		server.Server = scram.NewPlusServer(getChannelBinding(tlsState), server.lookup, server.authenticate)
+	} else {
+		server.Server = scram.NewServer(getChannelBinding(tlsState), server.lookup, server.authenticate)
+	}
+
+	return server
+}
+
+func (server *imapSCRAMServer) Next(response []byte) ([]byte, bool, error) {
+	challenge, done, err := server.Server.Next(response)
+
+	if errors.Is(err, scram.ErrInvalidProof) {
+		server.fail()
+	}
+
+	return challenge, done, err
+}
+
+// UserID returns the ID of the gluon user the session has authenticated as.
+func (server *imapSCRAMServer) UserID() string {
+	return server.gluonID
+}
+
+// lookup returns the SCRAM credentials of the user with the given address.
+// Unknown addresses get credentials without any verifier, with which no client can authenticate.
+func (server *imapSCRAMServer) lookup(username string) (scram.Credentials, error) {
+	server.username = username
+
+	if !server.bridge.authLimiter.AllowIMAPAuth(server.sessionID, username) {
+		return scram.Credentials{}, errIMAPAuthNotAllowed
 	}
+
+	return safe.RLockRetErr(func() (scram.Credentials, error) {
+		for _, user := range server.bridge.users {
+			if xslices.IndexFunc(user.Emails(), func(email string) bool { return strings.EqualFold(email, username) }) < 0 {
+				continue
+			}
+
+			if !(imapAuthChecker{Bridge: server.bridge}).AllowIMAPAuth(server.sessionID, user.ID(), username) {
+				return scram.Credentials{}, errIMAPAuthNotAllowed
+			}
+
+			credentials, appPassIDs, err := user.GetSCRAMCredentials()
+			if err != nil {
+				return scram.Credentials{}, err
+			}
+
+			server.userID = user.ID()
+			server.appPassIDs = appPassIDs
+
+			return credentials, nil
+		}
+
+		return scram.Credentials{Salt: scram.NewSalt(), Iterations: scram.Iterations}, nil
+	}, server.bridge.usersLock)
+}
+
+// authenticate logs the session in once the client has proved that it knows one of the user's passwords.
+func (server *imapSCRAMServer) authenticate(username string, verifier int) error {
+	return safe.RLockRet(func() error {
+		user, ok := server.bridge.users[server.userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		gluonID, err := user.AuthorizeIMAPSCRAM(server.ctx, server.sessionID, username, server.appPassIDs[verifier])
+		if err != nil {
+			server.fail()
+			return err
+		}
+
+		server.gluonID = gluonID
+
+		return nil
+	}, server.bridge.usersLock)
+}
+
+// fail reports a failed authentication attempt, as gluon does for the LOGIN command.
+func (server *imapSCRAMServer) fail() {
+	logrus.WithFields(logrus.Fields{
+		"sessionID": server.sessionID,
+		"username":  server.username,
+		"pkg":       "imap",
+	}).Error("Incorrect login credentials.")
+
+	server.bridge.publish(events.IMAPLoginFailed{Username: server.username})
+
+	server.bridge.authLimiter.fail(server.bridge.authLimiter.getIMAPSessionSource(server.sessionID), server.username)
 }
 
 func ApplyGluonCachePathSuffix(basePath string) string {
@@ -100,6 +241,22 @@ func ApplyGluonConfigPathSuffix(basePath string) string {
 	return filepath.Join(basePath, "backend", "db")
 }
 
+// DeleteGluonUsers removes the messages and the databases of the given gluon users, as the IMAP server does
+// when they are removed from it, for when it isn't running.
+func DeleteGluonUsers(gluonCacheDir, gluonDataDir string, gluonIDs []string) error {
+	for _, gluonID := range gluonIDs {
+		if err := new(storeBuilder).Delete(ApplyGluonCachePathSuffix(gluonCacheDir), gluonID); err != nil {
+			return fmt.Errorf("failed to delete gluon store %v: %w", gluonID, err)
+		}
+
+		if err := db.DeleteDB(ApplyGluonConfigPathSuffix(gluonDataDir), gluonID); err != nil {
+			return fmt.Errorf("failed to delete gluon database %v: %w", gluonID, err)
+		}
+	}
+
+	return nil
+}
+
 func newIMAPServer(
 	gluonCacheDir, gluonConfigDir string,
 	version *semver.Version,
@@ -110,6 +267,7 @@ func newIMAPServer(
 	tasks *async.Group,
 	uidValidityGenerator imap.UIDValidityGenerator,
 	panicHandler async.PanicHandler,
+	newSCRAMServer func(ctx context.Context, tlsState *tls.ConnectionState, plus bool) imap.SASLServer,
 ) (*gluon.Server, error) {
 	gluonCacheDir = ApplyGluonCachePathSuffix(gluonCacheDir)
 	gluonConfigDir = ApplyGluonConfigPathSuffix(gluonConfigDir)
@@ -145,6 +303,7 @@ func newIMAPServer(
 		imapServerLog = io.Discard
 	}
 
+	// With SCRAM, clients prove that they know the password without sending it, which matters when TLS is off.
 	imapServer, err := gluon.New(
 		gluon.WithTLS(tlsConfig),
 		gluon.WithDataDir(gluonCacheDir),
@@ -155,6 +314,12 @@ func newIMAPServer(
 		gluon.WithReporter(reporter),
 		gluon.WithUIDValidityGenerator(uidValidityGenerator),
 		gluon.WithPanicHandler(panicHandler),
+		gluon.WithSASLMechanism(scram.SHA256, func(ctx context.Context, tlsState *tls.ConnectionState) imap.SASLServer {
+			return newSCRAMServer(ctx, tlsState, false)
+		}),
+		gluon.WithSASLMechanism(scram.SHA256Plus, func(ctx context.Context, tlsState *tls.ConnectionState) imap.SASLServer {
+			return newSCRAMServer(ctx, tlsState, true)
+		}),
 	)
 	if err != nil {
 		return nil, err
@@ -78,7 +78,7 @@ func (j *TestCookieJar) Cookies(u *url.URL) []*http.Cookie {
 }
 
 type TestLocationsProvider struct {
-	config, data, cache string
+	config, data, cache, runtime string
 }
 
 func NewTestLocationsProvider(dir string) *TestLocationsProvider {
@@ -97,10 +97,16 @@ func NewTestLocationsProvider(dir string) *TestLocationsProvider {
 		panic(err)
 	}
 
+	runtime, err := os.MkdirTemp(dir, "runtime")
+	if err != nil {
+		panic(err)
+	}
+
 	return &TestLocationsProvider{
-		config: config,
-		data:   data,
-		cache:  cache,
+		config:  config,
+		data:    data,
+		cache:   cache,
+		runtime: runtime,
 	}
 }
 
@@ -116,6 +122,10 @@ func (provider *TestLocationsProvider) UserCache() string {
 	return provider.cache
 }
 
+func (provider *TestLocationsProvider) UserRuntime() string {
+	return provider.runtime
+}
+
 type TestUpdater struct {
 	latest updater.VersionInfo
 	lock   sync.RWMutex
@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+)
+
+// OutboxMessageInfo describes a message waiting in a user's outbox.
+type OutboxMessageInfo struct {
+	// ID is the ID of the message in the outbox.
+	ID string
+
+	// From is the return path of the message.
+	From string
+
+	// To holds the recipients of the message.
+	To []string
+
+	// Subject is the subject of the message.
+	Subject string
+
+	// Size is the size of the message literal, in bytes.
+	Size int
+
+	// QueuedAt is the time at which the message was queued.
+	QueuedAt time.Time
+
+	// Attempts is the number of times sending the message was attempted.
+	Attempts int
+
+	// LastError is the error of the last attempt to send the message.
+	LastError string
+}
+
+// GetOutbox returns the messages waiting in the given user's outbox, oldest first.
+// Messages stay in the outbox of signed out users until they log in again.
+func (bridge *Bridge) GetOutbox(userID string) ([]OutboxMessageInfo, error) {
+	messages, err := safe.RLockRetErr(func() ([]vault.OutboxMessage, error) {
+		if user, ok := bridge.users[userID]; ok {
+			return user.GetOutbox()
+		}
+
+		if !bridge.vault.HasUser(userID) {
+			return nil, ErrNoSuchUser
+		}
+
+		var (
+			messages []vault.OutboxMessage
+			err      error
+		)
+
+		if vaultErr := bridge.vault.GetUser(userID, func(user *vault.User) {
+			messages, err = user.GetOutbox()
+		}); vaultErr != nil {
+			return nil, vaultErr
+		}
+
+		return messages, err
+	}, bridge.usersLock)
+	if err != nil {
+		return nil, err
+	}
+
+	return xslices.Map(messages, newOutboxMessageInfo), nil
+}
+
+// CancelOutboxMessage removes a message from the given user's outbox so that it is never sent.
+func (bridge *Bridge) CancelOutboxMessage(userID, messageID string) error {
+	return safe.RLockRet(func() error {
+		if user, ok := bridge.users[userID]; ok {
+			return user.CancelOutboxMessage(messageID)
+		}
+
+		if !bridge.vault.HasUser(userID) {
+			return ErrNoSuchUser
+		}
+
+		var err error
+
+		if vaultErr := bridge.vault.GetUser(userID, func(user *vault.User) {
+			err = user.RemoveOutboxMessage(messageID)
+		}); vaultErr != nil {
+			return vaultErr
+		}
+
+		return err
+	}, bridge.usersLock)
+}
+
+func newOutboxMessageInfo(message vault.OutboxMessage) OutboxMessageInfo {
+	var subject string
+
+	if header, err := rfc822.Parse(message.Literal).ParseHeader(); err == nil {
+		subject = header.Get("Subject")
+	}
+
+	return OutboxMessageInfo{
+		ID:        message.ID,
+		From:      message.From,
+		To:        message.To,
+		Subject:   subject,
+		Size:      len(message.Literal),
+		QueuedAt:  message.QueuedAt,
+		Attempts:  message.Attempts,
+		LastError: message.LastError,
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ProtonMail/gluon/store"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// gluonRekeySuffix is appended to the directory of a gluon store while it is re-encrypted with a new key.
+	gluonRekeySuffix = ".rekey"
+
+	// gluonOldSuffix is appended to the directory of a gluon store replaced by the re-encrypted one, until it is removed.
+	gluonOldSuffix = ".old"
+)
+
+// RekeyVault rotates the key of the vault, which is stored in the keychain, and the keys of the users' gluon databases.
+// The vault and the messages stored by gluon are re-encrypted with the new keys; the IMAP server is restarted meanwhile.
+func (bridge *Bridge) RekeyVault(ctx context.Context) error {
+	vaultDir, err := bridge.locator.ProvideSettingsPath()
+	if err != nil {
+		return err
+	}
+
+	if bridge.isVaultInsecure(vaultDir) {
+		return ErrVaultInsecure
+	}
+
+	helper, err := vault.GetHelper(vaultDir)
+	if err != nil {
+		return fmt.Errorf("could not get keychain helper: %w", err)
+	}
+
+	kc, err := keychain.NewKeychain(helper, constants.KeyChainName)
+	if err != nil {
+		return fmt.Errorf("could not create keychain: %w", err)
+	}
+
+	if err := bridge.serverManager.RekeyGluon(ctx); err != nil {
+		return fmt.Errorf("failed to rotate gluon keys: %w", err)
+	}
+
+	if err := vault.RekeyVault(kc, bridge.vault); err != nil {
+		return fmt.Errorf("failed to rotate vault key: %w", err)
+	}
+
+	return nil
+}
+
+// isVaultInsecure returns whether the vault is the insecure one, used when the keychain isn't available,
+// which isn't stored in the given settings directory.
+func (bridge *Bridge) isVaultInsecure(vaultDir string) bool {
+	return filepath.Dir(bridge.vault.Path()) != vaultDir
+}
+
+// rekeyGluonStores re-encrypts the messages stored by gluon for each of the user's addresses with a new key,
+// which then replaces the user's one. The IMAP server must be closed.
+// The new key is written to the vault as pending before the re-encrypted stores replace the current ones,
+// so that the replacement can be completed when bridge next starts if it stops meanwhile.
+func (bridge *Bridge) rekeyGluonStores(user *user.User) error {
+	gluonStoreDir := ApplyGluonCachePathSuffix(bridge.vault.GetGluonCacheDir())
+	gluonIDs := maps.Values(user.GetGluonIDs())
+	oldKey, newKey := user.GluonKey(), vault.NewGluonKey()
+
+	defer removeRekeyedGluonStores(gluonStoreDir, gluonIDs)
+
+	for _, gluonID := range gluonIDs {
+		if err := rekeyGluonStore(gluonStoreDir, gluonID, oldKey, newKey); err != nil {
+			return fmt.Errorf("failed to re-encrypt gluon store %v: %w", gluonID, err)
+		}
+	}
+
+	if err := user.SetPendingGluonKey(newKey); err != nil {
+		return fmt.Errorf("failed to set pending gluon key: %w", err)
+	}
+
+	if err := bridge.vault.Flush(); err != nil {
+		bridge.clearPendingGluonKey(user)
+		return fmt.Errorf("failed to write pending gluon key: %w", err)
+	}
+
+	if err := replaceGluonStores(gluonStoreDir, gluonIDs); err != nil {
+		restoreGluonStores(gluonStoreDir, gluonIDs)
+		bridge.clearPendingGluonKey(user)
+
+		return fmt.Errorf("failed to replace gluon stores: %w", err)
+	}
+
+	if err := user.PromotePendingGluonKey(); err != nil {
+		restoreGluonStores(gluonStoreDir, gluonIDs)
+		bridge.clearPendingGluonKey(user)
+
+		return fmt.Errorf("failed to set gluon key: %w", err)
+	}
+
+	// If the vault can't be written now, the pending key is promoted again when bridge next starts.
+	if err := bridge.vault.Flush(); err != nil {
+		logrus.WithError(err).Warn("Failed to write gluon key")
+	}
+
+	return nil
+}
+
+// clearPendingGluonKey clears the pending gluon key of the given user once the stores re-encrypted with it were discarded.
+func (bridge *Bridge) clearPendingGluonKey(user *user.User) {
+	if err := user.SetPendingGluonKey(nil); err != nil {
+		logrus.WithError(err).Error("Failed to clear pending gluon key")
+	}
+
+	if err := bridge.vault.Flush(); err != nil {
+		logrus.WithError(err).Error("Failed to write vault")
+	}
+}
+
+// recoverGluonRekeys completes rotating the users' gluon keys if bridge stopped after the pending key was written,
+// by replacing the stores which weren't yet with the re-encrypted ones. Otherwise, the re-encrypted stores left behind
+// by an interrupted rotation are removed, along with the previous stores left behind by a completed one.
+func recoverGluonRekeys(encVault *vault.Vault) {
+	gluonStoreDir := ApplyGluonCachePathSuffix(encVault.GetGluonCacheDir())
+
+	for _, userID := range encVault.GetUserIDs() {
+		if err := encVault.GetUser(userID, func(user *vault.User) {
+			if err := recoverGluonRekey(gluonStoreDir, user); err != nil {
+				logrus.WithError(err).WithField("userID", userID).Error("Failed to complete gluon key rotation")
+			}
+		}); err != nil {
+			logrus.WithError(err).WithField("userID", userID).Error("Failed to get user")
+		}
+	}
+}
+
+func recoverGluonRekey(gluonStoreDir string, user *vault.User) error {
+	gluonIDs := maps.Values(user.GetGluonIDs())
+
+	if user.PendingGluonKey() == nil {
+		removeRekeyedGluonStores(gluonStoreDir, gluonIDs)
+		return nil
+	}
+
+	logrus.WithField("userID", user.UserID()).Warn("Completing gluon key rotation interrupted by bridge stopping")
+
+	// The stores which were already replaced have no re-encrypted one left; the others are replaced now.
+	for _, gluonID := range gluonIDs {
+		dir := filepath.Join(gluonStoreDir, gluonID)
+
+		if _, err := os.Stat(dir + gluonRekeySuffix); err != nil {
+			continue
+		}
+
+		if err := os.Rename(dir, dir+gluonOldSuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		if err := os.Rename(dir+gluonRekeySuffix, dir); err != nil {
+			return err
+		}
+	}
+
+	if err := user.PromotePendingGluonKey(); err != nil {
+		return err
+	}
+
+	removeRekeyedGluonStores(gluonStoreDir, gluonIDs)
+
+	return nil
+}
+
+// removeRekeyedGluonStores removes the re-encrypted gluon stores which didn't replace the given ones,
+// and the previous ones which were replaced.
+func removeRekeyedGluonStores(gluonStoreDir string, gluonIDs []string) {
+	for _, gluonID := range gluonIDs {
+		dir := filepath.Join(gluonStoreDir, gluonID)
+
+		if err := os.RemoveAll(dir + gluonRekeySuffix); err != nil {
+			logrus.WithError(err).Error("Failed to remove re-encrypted gluon store")
+		}
+
+		if err := os.RemoveAll(dir + gluonOldSuffix); err != nil {
+			logrus.WithError(err).Error("Failed to remove previous gluon store")
+		}
+	}
+}
+
+// rekeyGluonStore copies the messages of the given gluon store, encrypted with the old key, to a new store next to it
+// encrypted with the new key.
+func rekeyGluonStore(gluonStoreDir, gluonID string, oldKey, newKey []byte) error {
+	oldStore, err := new(storeBuilder).New(gluonStoreDir, gluonID, oldKey)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = oldStore.Close() }()
+
+	newDir := filepath.Join(gluonStoreDir, gluonID+gluonRekeySuffix)
+
+	if err := os.RemoveAll(newDir); err != nil {
+		return err
+	}
+
+	newStore, err := store.NewOnDiskStore(newDir, newKey)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = newStore.Close() }()
+
+	messageIDs, err := oldStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, messageID := range messageIDs {
+		literal, err := oldStore.Get(messageID)
+		if err != nil {
+			return fmt.Errorf("failed to read message %v: %w", messageID, err)
+		}
+
+		if err := newStore.Set(messageID, bytes.NewReader(literal)); err != nil {
+			return fmt.Errorf("failed to write message %v: %w", messageID, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceGluonStores replaces the given gluon stores with the re-encrypted ones, keeping them aside until they are removed.
+func replaceGluonStores(gluonStoreDir string, gluonIDs []string) error {
+	for _, gluonID := range gluonIDs {
+		dir := filepath.Join(gluonStoreDir, gluonID)
+
+		if err := os.Rename(dir, dir+gluonOldSuffix); err != nil {
+			return err
+		}
+
+		if err := os.Rename(dir+gluonRekeySuffix, dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreGluonStores puts back the given gluon stores which were replaced with the re-encrypted ones.
+func restoreGluonStores(gluonStoreDir string, gluonIDs []string) {
+	for _, gluonID := range gluonIDs {
+		dir := filepath.Join(gluonStoreDir, gluonID)
+
+		if _, err := os.Stat(dir + gluonOldSuffix); err != nil {
+			continue
+		}
+
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.WithError(err).Error("Failed to remove re-encrypted gluon store")
+		}
+
+		if err := os.Rename(dir+gluonOldSuffix, dir); err != nil {
+			logrus.WithError(err).Error("Failed to restore previous gluon store")
+		}
+	}
+}
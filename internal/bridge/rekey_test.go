@@ -0,0 +1,262 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/gluon/store"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/bradenaw/juniper/xslices"
+	dockerCredentials "github.com/docker/docker-credential-helpers/credentials"
+	"github.com/emersion/go-imap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_RekeyVault(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		_, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 10)
+		})
+
+		// Store the vault key in a keychain.
+		vaultDir, err := locator.ProvideSettingsPath()
+		require.NoError(t, err)
+
+		keychainHelper := keychain.NewTestHelper()
+
+		keychain.Helpers["mock"] = func(string) (dockerCredentials.Helper, error) { return keychainHelper, nil }
+
+		require.NoError(t, vault.SetHelper(vaultDir, "mock"))
+
+		kc, err := keychain.NewKeychain("mock", constants.KeyChainName)
+		require.NoError(t, err)
+		require.NoError(t, vault.SetVaultKey(kc, vaultKey))
+
+		// Login the user and sync its messages to the gluon store.
+		var userID string
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err = b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+		})
+
+		gluonKey := getGluonKey(t, vaultDir, vaultKey, userID)
+
+		// Rotate the keys.
+		var storeDir string
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.NoError(t, b.RekeyVault(ctx))
+
+			storeDir = bridge.ApplyGluonCachePathSuffix(b.GetGluonCacheDir())
+		})
+
+		// Both keys were replaced.
+		newVaultKey, err := vault.GetVaultKey(kc)
+		require.NoError(t, err)
+		require.NotEqual(t, vaultKey, newVaultKey)
+
+		newGluonKey := getGluonKey(t, vaultDir, newVaultKey, userID)
+		require.NotEqual(t, gluonKey, newGluonKey)
+
+		// Only the re-encrypted store is left, whose messages can only be read with the new gluon key.
+		entries, err := os.ReadDir(storeDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		oldStore, err := store.NewOnDiskStore(filepath.Join(storeDir, entries[0].Name()), gluonKey)
+		require.NoError(t, err)
+
+		newStore, err := store.NewOnDiskStore(filepath.Join(storeDir, entries[0].Name()), newGluonKey)
+		require.NoError(t, err)
+
+		messageIDs, err := newStore.List()
+		require.NoError(t, err)
+		require.Len(t, messageIDs, 10)
+
+		for _, messageID := range messageIDs {
+			_, err := oldStore.Get(messageID)
+			require.Error(t, err)
+
+			_, err = newStore.Get(messageID)
+			require.NoError(t, err)
+		}
+
+		// The bridge starts with the new vault key, and the messages can still be fetched.
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, newVaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.True(t, info.State == bridge.Connected)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			defer func() { _ = client.Logout() }()
+
+			messages, err := clientFetch(client, "INBOX")
+			require.NoError(t, err)
+			require.Len(t, messages, 10)
+
+			for _, message := range messages {
+				literal, err := io.ReadAll(message.GetBody(must(imap.ParseBodySectionName("BODY[]"))))
+				require.NoError(t, err)
+
+				_, err = rfc822.Parse(literal).ParseHeader()
+				require.NoError(t, err)
+			}
+		})
+	})
+}
+
+func TestBridge_RekeyGluonRecovery(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, vaultKey []byte) {
+		_, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 10)
+		})
+
+		// Login the user and sync its messages to the gluon store.
+		var (
+			userID   string
+			storeDir string
+		)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err = b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			storeDir = bridge.ApplyGluonCachePathSuffix(b.GetGluonCacheDir())
+		})
+
+		vaultDir, err := locator.ProvideSettingsPath()
+		require.NoError(t, err)
+
+		// Bridge stopped after writing the pending key, before replacing the store with the re-encrypted one.
+		newGluonKey := vault.NewGluonKey()
+
+		var gluonID string
+
+		withVault(t, vaultDir, vaultKey, func(v *vault.Vault) {
+			require.NoError(t, v.GetUser(userID, func(user *vault.User) {
+				gluonID = user.GetGluonIDs()[addrID]
+
+				oldStore, err := store.NewOnDiskStore(filepath.Join(storeDir, gluonID), user.GluonKey())
+				require.NoError(t, err)
+				defer func() { require.NoError(t, oldStore.Close()) }()
+
+				newStore, err := store.NewOnDiskStore(filepath.Join(storeDir, gluonID+".rekey"), newGluonKey)
+				require.NoError(t, err)
+				defer func() { require.NoError(t, newStore.Close()) }()
+
+				messageIDs, err := oldStore.List()
+				require.NoError(t, err)
+				require.Len(t, messageIDs, 10)
+
+				for _, messageID := range messageIDs {
+					literal, err := oldStore.Get(messageID)
+					require.NoError(t, err)
+					require.NoError(t, newStore.Set(messageID, bytes.NewReader(literal)))
+				}
+
+				require.NoError(t, user.SetPendingGluonKey(newGluonKey))
+			}))
+		})
+
+		// The rotation is completed when the bridge starts, and the messages can still be fetched.
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			defer func() { _ = client.Logout() }()
+
+			messages, err := clientFetch(client, "INBOX")
+			require.NoError(t, err)
+			require.Len(t, messages, 10)
+		})
+
+		require.Equal(t, newGluonKey, getGluonKey(t, vaultDir, vaultKey, userID))
+
+		// Re-encrypted stores left behind without a pending key are discarded, as their key was never written.
+		require.NoError(t, os.Mkdir(filepath.Join(storeDir, gluonID+".rekey"), 0o700))
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(*bridge.Bridge, *bridge.Mocks) {})
+
+		require.Equal(t, newGluonKey, getGluonKey(t, vaultDir, vaultKey, userID))
+
+		entries, err := os.ReadDir(storeDir)
+		require.NoError(t, err)
+		require.Equal(t, []string{gluonID}, xslices.Map(entries, func(entry os.DirEntry) string { return entry.Name() }))
+	})
+}
+
+func withVault(t *testing.T, vaultDir string, vaultKey []byte, fn func(*vault.Vault)) {
+	t.Helper()
+
+	v, corrupt, err := vault.New(vaultDir, t.TempDir(), vaultKey, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+
+	defer func() { require.NoError(t, v.Close()) }()
+
+	fn(v)
+}
+
+func getGluonKey(t *testing.T, vaultDir string, vaultKey []byte, userID string) []byte {
+	t.Helper()
+
+	var gluonKey []byte
+
+	withVault(t, vaultDir, vaultKey, func(v *vault.Vault) {
+		require.NoError(t, v.GetUser(userID, func(user *vault.User) {
+			gluonKey = user.GluonKey()
+		}))
+	})
+
+	return gluonKey
+}
@@ -121,6 +121,132 @@ func TestBridge_Send(t *testing.T) {
 	})
 }
 
+func TestBridge_SendDSNParams(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("recipient", password)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			senderUserID, err := bridge.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			senderInfo, err := bridge.GetUserInfo(senderUserID)
+			require.NoError(t, err)
+
+			client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetSMTPPort())))
+			require.NoError(t, err)
+			defer client.Close() //nolint:errcheck
+
+			require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+
+			// The DSN extension is advertised.
+			ok, _ := client.Extension("DSN")
+			require.True(t, ok)
+
+			require.NoError(t, client.Auth(sasl.NewPlainClient(
+				senderInfo.Addresses[0],
+				senderInfo.Addresses[0],
+				string(senderInfo.BridgePass)),
+			))
+
+			// The RET and ENVID parameters are accepted.
+			require.NoError(t, client.Mail(senderInfo.Addresses[0], &smtp.MailOptions{
+				Return:     smtp.DSNReturnFull,
+				EnvelopeID: "QQ+314159",
+			}))
+
+			// The NOTIFY and ORCPT parameters are accepted, and only the address is echoed back.
+			id, err := client.Text.Cmd("RCPT TO:<recipient@%v> NOTIFY=FAILURE,DELAY ORCPT=rfc822;recipient@%v", s.GetDomain(), s.GetDomain())
+			require.NoError(t, err)
+
+			client.Text.StartResponse(id)
+			_, msg, err := client.Text.ReadResponse(250)
+			client.Text.EndResponse(id)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("2.0.0 I'll make sure <recipient@%v> gets this", s.GetDomain()), msg)
+
+			w, err := client.Data()
+			require.NoError(t, err)
+
+			_, err = w.Write([]byte("Subject: Test\r\n\r\nHello world!"))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+		})
+	})
+}
+
+func TestBridge_SendScheduled(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			userID, err := bridge.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			info, err := bridge.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetSMTPPort())))
+			require.NoError(t, err)
+			defer smtpClient.Close() //nolint:errcheck
+
+			require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+			require.NoError(t, smtpClient.Auth(sasl.NewPlainClient(info.Addresses[0], info.Addresses[0], string(info.BridgePass))))
+
+			// Send a message which should only be delivered in an hour.
+			require.NoError(t, smtpClient.SendMail(
+				info.Addresses[0],
+				[]string{"recipient@example.com"},
+				strings.NewReader(fmt.Sprintf(
+					"Subject: Later\r\nX-Pm-Schedule-Send: %v\r\n\r\nHello world!",
+					time.Now().Add(time.Hour).Format(time.RFC3339),
+				)),
+			))
+
+			imapClient, err := eventuallyDial(net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetIMAPPort())))
+			require.NoError(t, err)
+			require.NoError(t, imapClient.Login(info.Addresses[0], string(info.BridgePass)))
+			defer imapClient.Logout() //nolint:errcheck
+
+			// The message is scheduled rather than sent.
+			require.Eventually(t, func() bool {
+				status, err := imapClient.Status(`Scheduled`, []imap.StatusItem{imap.StatusMessages})
+				require.NoError(t, err)
+
+				return status.Messages == 1
+			}, 10*time.Second, 100*time.Millisecond)
+
+			status, err := imapClient.Status(`Sent`, []imap.StatusItem{imap.StatusMessages})
+			require.NoError(t, err)
+			require.Zero(t, status.Messages)
+
+			// Moving it out of the scheduled messages cancels sending it; it becomes a draft again.
+			_, err = imapClient.Select(`Scheduled`, false)
+			require.NoError(t, err)
+
+			seq := new(imap.SeqSet)
+			seq.AddNum(1)
+
+			require.NoError(t, imapClient.Move(seq, `Drafts`))
+
+			require.Eventually(t, func() bool {
+				status, err := imapClient.Status(`Drafts`, []imap.StatusItem{imap.StatusMessages})
+				require.NoError(t, err)
+
+				return status.Messages == 1
+			}, 10*time.Second, 100*time.Millisecond)
+		})
+	})
+}
+
 func TestBridge_SendDraftFlags(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
 		// Create a recipient user.
@@ -19,6 +19,7 @@ package bridge
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"path/filepath"
@@ -38,11 +39,11 @@ import (
 type ServerManager struct {
 	requests *cpc.CPC
 
-	imapServer   *gluon.Server
-	imapListener net.Listener
+	imapServer    *gluon.Server
+	imapListeners []net.Listener
 
-	smtpServer   *smtp.Server
-	smtpListener net.Listener
+	smtpServer    *smtp.Server
+	smtpListeners []net.Listener
 
 	loadedUserCount int
 }
@@ -117,6 +118,13 @@ func (sm *ServerManager) SetGluonDir(ctx context.Context, gluonDir string) error
 	return err
 }
 
+// RekeyGluon rotates the keys of the users' gluon databases, re-encrypting them, while the IMAP server is closed.
+func (sm *ServerManager) RekeyGluon(ctx context.Context) error {
+	_, err := sm.requests.Send(ctx, &smRequestRekeyGluon{})
+
+	return err
+}
+
 func (sm *ServerManager) AddGluonUser(ctx context.Context, conn connector.Connector, passphrase []byte) (string, error) {
 	reply, err := cpc.SendTyped[string](ctx, sm.requests, &smRequestAddGluonUser{
 		conn:       conn,
@@ -199,6 +207,10 @@ func (sm *ServerManager) run(ctx context.Context, bridge *Bridge) {
 				err := sm.handleSetGluonDir(ctx, bridge, r.dir)
 				request.Reply(ctx, nil, err)
 
+			case *smRequestRekeyGluon:
+				err := sm.handleRekeyGluon(ctx, bridge)
+				request.Reply(ctx, nil, err)
+
 			case *smRequestAddGluonUser:
 				id, err := sm.handleAddGluonUser(ctx, r.conn, r.passphrase)
 				request.Reply(ctx, id, err)
@@ -214,25 +226,25 @@ func (sm *ServerManager) run(ctx context.Context, bridge *Bridge) {
 func (sm *ServerManager) handleLoadedUserCountChange(ctx context.Context, bridge *Bridge) {
 	logrus.Infof("Validating Listener State %v", sm.loadedUserCount)
 	if sm.shouldStartServers() {
-		if sm.imapListener == nil {
+		if len(sm.imapListeners) == 0 {
 			if err := sm.serveIMAP(ctx, bridge); err != nil {
 				logrus.WithError(err).Error("Failed to start IMAP server")
 			}
 		}
 
-		if sm.smtpListener == nil {
+		if len(sm.smtpListeners) == 0 {
 			if err := sm.restartSMTP(bridge); err != nil {
 				logrus.WithError(err).Error("Failed to start SMTP server")
 			}
 		}
 	} else {
-		if sm.imapListener != nil {
+		if len(sm.imapListeners) > 0 {
 			if err := sm.stopIMAPListener(bridge); err != nil {
 				logrus.WithError(err).Error("Failed to stop IMAP server")
 			}
 		}
 
-		if sm.smtpListener != nil {
+		if len(sm.smtpListeners) > 0 {
 			if err := sm.closeSMTPServer(bridge); err != nil {
 				logrus.WithError(err).Error("Failed to stop SMTP server")
 			}
@@ -383,6 +395,7 @@ func createIMAPServer(bridge *Bridge) (*gluon.Server, error) {
 		bridge.tasks,
 		bridge.uidValidityGenerator,
 		bridge.panicHandler,
+		bridge.newIMAPSCRAMServer,
 	)
 }
 
@@ -391,18 +404,18 @@ func createSMTPServer(bridge *Bridge) *smtp.Server {
 }
 
 func (sm *ServerManager) closeSMTPServer(bridge *Bridge) error {
-	// We close the listener ourselves even though it's also closed by smtpServer.Close().
+	// We close the listeners ourselves even though they're also closed by smtpServer.Close().
 	// This is because smtpServer.Serve() is called in a separate goroutine and might be executed
 	// after we've already closed the server. However, go-smtp has a bug; it blocks on the listener
-	// even after the server has been closed. So we close the listener ourselves to unblock it.
+	// even after the server has been closed. So we close the listeners ourselves to unblock it.
 
-	if sm.smtpListener != nil {
-		logrus.Info("Closing SMTP Listener")
-		if err := sm.smtpListener.Close(); err != nil {
+	if len(sm.smtpListeners) > 0 {
+		logrus.Info("Closing SMTP Listeners")
+		if err := closeListeners(sm.smtpListeners); err != nil {
 			return fmt.Errorf("failed to close SMTP listener: %w", err)
 		}
 
-		sm.smtpListener = nil
+		sm.smtpListeners = nil
 	}
 
 	if sm.smtpServer != nil {
@@ -420,14 +433,14 @@ func (sm *ServerManager) closeSMTPServer(bridge *Bridge) error {
 }
 
 func (sm *ServerManager) closeIMAPServer(ctx context.Context, bridge *Bridge) error {
-	if sm.imapListener != nil {
-		logrus.Info("Closing IMAP Listener")
+	if len(sm.imapListeners) > 0 {
+		logrus.Info("Closing IMAP Listeners")
 
-		if err := sm.imapListener.Close(); err != nil {
+		if err := closeListeners(sm.imapListeners); err != nil {
 			return fmt.Errorf("failed to close IMAP listener: %w", err)
 		}
 
-		sm.imapListener = nil
+		sm.imapListeners = nil
 
 		bridge.publish(events.IMAPServerStopped{})
 	}
@@ -447,12 +460,12 @@ func (sm *ServerManager) closeIMAPServer(ctx context.Context, bridge *Bridge) er
 func (sm *ServerManager) restartIMAP(ctx context.Context, bridge *Bridge) error {
 	logrus.Info("Restarting IMAP server")
 
-	if sm.imapListener != nil {
-		if err := sm.imapListener.Close(); err != nil {
+	if len(sm.imapListeners) > 0 {
+		if err := closeListeners(sm.imapListeners); err != nil {
 			return fmt.Errorf("failed to close IMAP listener: %w", err)
 		}
 
-		sm.imapListener = nil
+		sm.imapListeners = nil
 
 		bridge.publish(events.IMAPServerStopped{})
 	}
@@ -485,28 +498,40 @@ func (sm *ServerManager) restartSMTP(bridge *Bridge) error {
 func (sm *ServerManager) serveSMTP(bridge *Bridge) error {
 	port, err := func() (int, error) {
 		logrus.WithFields(logrus.Fields{
-			"port": bridge.vault.GetSMTPPort(),
-			"ssl":  bridge.vault.GetSMTPSSL(),
+			"port":       bridge.vault.GetSMTPPort(),
+			"ssl":        bridge.vault.GetSMTPSSL(),
+			"listeners":  bridge.vault.GetSMTPListeners(),
+			"unixSocket": bridge.vault.GetUnixSockets(),
 		}).Info("Starting SMTP server")
 
-		smtpListener, err := newListener(bridge.vault.GetSMTPPort(), bridge.vault.GetSMTPSSL(), bridge.tlsConfig)
+		socketPath, err := bridge.getSocketPath(smtpSocketName)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get SMTP socket path: %w", err)
+		}
+
+		smtpListeners, err := newListeners(bridge.vault.GetSMTPPort(), bridge.vault.GetSMTPSSL(), bridge.vault.GetSMTPListeners(), socketPath, bridge.tlsConfig, bridge.tlsPeers)
 		if err != nil {
 			return 0, fmt.Errorf("failed to create SMTP listener: %w", err)
 		}
 
-		sm.smtpListener = smtpListener
+		sm.smtpListeners = smtpListeners
 
-		bridge.tasks.Once(func(context.Context) {
-			if err := sm.smtpServer.Serve(smtpListener); err != nil {
-				logrus.WithError(err).Info("SMTP server stopped")
-			}
-		})
+		for _, smtpListener := range smtpListeners {
+			smtpListener := smtpListener
+
+			bridge.tasks.Once(func(context.Context) {
+				if err := sm.smtpServer.Serve(smtpListener); err != nil {
+					logrus.WithError(err).Info("SMTP server stopped")
+				}
+			})
+		}
 
-		if err := bridge.vault.SetSMTPPort(getPort(smtpListener.Addr())); err != nil {
+		// The first listener is the one on localhost; its port may have been chosen by the system.
+		if err := bridge.vault.SetSMTPPort(getPort(smtpListeners[0].Addr())); err != nil {
 			return 0, fmt.Errorf("failed to store SMTP port in vault: %w", err)
 		}
 
-		return getPort(smtpListener.Addr()), nil
+		return getPort(smtpListeners[0].Addr()), nil
 	}()
 
 	if err != nil {
@@ -531,26 +556,36 @@ func (sm *ServerManager) serveIMAP(ctx context.Context, bridge *Bridge) error {
 		}
 
 		logrus.WithFields(logrus.Fields{
-			"port": bridge.vault.GetIMAPPort(),
-			"ssl":  bridge.vault.GetIMAPSSL(),
+			"port":       bridge.vault.GetIMAPPort(),
+			"ssl":        bridge.vault.GetIMAPSSL(),
+			"listeners":  bridge.vault.GetIMAPListeners(),
+			"unixSocket": bridge.vault.GetUnixSockets(),
 		}).Info("Starting IMAP server")
 
-		imapListener, err := newListener(bridge.vault.GetIMAPPort(), bridge.vault.GetIMAPSSL(), bridge.tlsConfig)
+		socketPath, err := bridge.getSocketPath(imapSocketName)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get IMAP socket path: %w", err)
+		}
+
+		imapListeners, err := newListeners(bridge.vault.GetIMAPPort(), bridge.vault.GetIMAPSSL(), bridge.vault.GetIMAPListeners(), socketPath, bridge.tlsConfig, bridge.tlsPeers)
 		if err != nil {
 			return 0, fmt.Errorf("failed to create IMAP listener: %w", err)
 		}
 
-		sm.imapListener = imapListener
+		sm.imapListeners = imapListeners
 
-		if err := sm.imapServer.Serve(ctx, sm.imapListener); err != nil {
-			return 0, fmt.Errorf("failed to serve IMAP: %w", err)
+		for _, imapListener := range imapListeners {
+			if err := sm.imapServer.Serve(ctx, imapListener); err != nil {
+				return 0, fmt.Errorf("failed to serve IMAP: %w", err)
+			}
 		}
 
-		if err := bridge.vault.SetIMAPPort(getPort(imapListener.Addr())); err != nil {
+		// The first listener is the one on localhost; its port may have been chosen by the system.
+		if err := bridge.vault.SetIMAPPort(getPort(imapListeners[0].Addr())); err != nil {
 			return 0, fmt.Errorf("failed to store IMAP port in vault: %w", err)
 		}
 
-		return getPort(imapListener.Addr()), nil
+		return getPort(imapListeners[0].Addr()), nil
 	}()
 
 	if err != nil {
@@ -570,12 +605,12 @@ func (sm *ServerManager) serveIMAP(ctx context.Context, bridge *Bridge) error {
 
 func (sm *ServerManager) stopIMAPListener(bridge *Bridge) error {
 	logrus.Info("Stopping IMAP listener")
-	if sm.imapListener != nil {
-		if err := sm.imapListener.Close(); err != nil {
+	if len(sm.imapListeners) > 0 {
+		if err := closeListeners(sm.imapListeners); err != nil {
 			return err
 		}
 
-		sm.imapListener = nil
+		sm.imapListeners = nil
 
 		bridge.publish(events.IMAPServerStopped{})
 	}
@@ -607,46 +642,77 @@ func (sm *ServerManager) handleSetGluonDir(ctx context.Context, bridge *Bridge,
 
 		bridge.heartbeat.SetCacheLocation(newGluonDir)
 
-		gluonDataDir, err := bridge.GetGluonDataDir()
-		if err != nil {
-			return fmt.Errorf("failed to get Gluon Database directory: %w", err)
-		}
+		return sm.reopenIMAPServer(ctx, bridge)
+	}, bridge.usersLock)
+}
 
-		imapServer, err := newIMAPServer(
-			bridge.vault.GetGluonCacheDir(),
-			gluonDataDir,
-			bridge.curVersion,
-			bridge.tlsConfig,
-			bridge.reporter,
-			bridge.logIMAPClient,
-			bridge.logIMAPServer,
-			bridge.imapEventCh,
-			bridge.tasks,
-			bridge.uidValidityGenerator,
-			bridge.panicHandler,
-		)
-		if err != nil {
-			return fmt.Errorf("failed to create new IMAP server: %w", err)
+func (sm *ServerManager) handleRekeyGluon(ctx context.Context, bridge *Bridge) error {
+	return safe.RLockRet(func() error {
+		if err := sm.closeIMAPServer(ctx, bridge); err != nil {
+			return fmt.Errorf("failed to close IMAP: %w", err)
 		}
 
-		sm.imapServer = imapServer
+		sm.loadedUserCount = 0
+
+		var errs []error
+
 		for _, bridgeUser := range bridge.users {
-			if err := sm.handleAddIMAPUser(ctx, bridgeUser); err != nil {
-				return fmt.Errorf("failed to add users to new IMAP server: %w", err)
+			if err := bridge.rekeyGluonStores(bridgeUser); err != nil {
+				errs = append(errs, fmt.Errorf("failed to rekey gluon database of user %v: %w", bridgeUser.ID(), err))
 			}
-			sm.loadedUserCount++
 		}
 
-		if sm.shouldStartServers() {
-			if err := sm.serveIMAP(ctx, bridge); err != nil {
-				return fmt.Errorf("failed to serve IMAP: %w", err)
-			}
+		// The IMAP server is reopened even if some users couldn't be rekeyed, as their previous key is then kept.
+		if err := sm.reopenIMAPServer(ctx, bridge); err != nil {
+			errs = append(errs, err)
 		}
 
-		return nil
+		return errors.Join(errs...)
 	}, bridge.usersLock)
 }
 
+// reopenIMAPServer creates a new IMAP server once the previous one was closed, adds the users to it and serves it.
+func (sm *ServerManager) reopenIMAPServer(ctx context.Context, bridge *Bridge) error {
+	gluonDataDir, err := bridge.GetGluonDataDir()
+	if err != nil {
+		return fmt.Errorf("failed to get Gluon Database directory: %w", err)
+	}
+
+	imapServer, err := newIMAPServer(
+		bridge.vault.GetGluonCacheDir(),
+		gluonDataDir,
+		bridge.curVersion,
+		bridge.tlsConfig,
+		bridge.reporter,
+		bridge.logIMAPClient,
+		bridge.logIMAPServer,
+		bridge.imapEventCh,
+		bridge.tasks,
+		bridge.uidValidityGenerator,
+		bridge.panicHandler,
+		bridge.newIMAPSCRAMServer,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create new IMAP server: %w", err)
+	}
+
+	sm.imapServer = imapServer
+	for _, bridgeUser := range bridge.users {
+		if err := sm.handleAddIMAPUser(ctx, bridgeUser); err != nil {
+			return fmt.Errorf("failed to add users to new IMAP server: %w", err)
+		}
+		sm.loadedUserCount++
+	}
+
+	if sm.shouldStartServers() {
+		if err := sm.serveIMAP(ctx, bridge); err != nil {
+			return fmt.Errorf("failed to serve IMAP: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (sm *ServerManager) handleAddGluonUser(ctx context.Context, conn connector.Connector, passphrase []byte) (string, error) {
 	if sm.imapServer == nil {
 		return "", fmt.Errorf("no imap server instance running")
@@ -686,6 +752,8 @@ type smRequestSetGluonDir struct {
 	dir string
 }
 
+type smRequestRekeyGluon struct{}
+
 type smRequestAddGluonUser struct {
 	conn       connector.Connector
 	passphrase []byte
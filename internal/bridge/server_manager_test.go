@@ -20,6 +20,8 @@ package bridge_test
 import (
 	"context"
 	"fmt"
+	"net"
+	"os"
 	"testing"
 
 	"github.com/ProtonMail/go-proton-api"
@@ -27,6 +29,10 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/emersion/go-imap/client"
+	"github.com/emersion/go-smtp"
 	"github.com/stretchr/testify/require"
 )
 
@@ -177,3 +183,109 @@ func TestServerManager_NetworkLossStopsServers(t *testing.T) {
 		})
 	})
 }
+
+func TestServerManager_AdditionalListeners(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(bridge)
+			defer imapWaiter.Done()
+
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			_, err := bridge.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+			smtpWaiter.Wait()
+
+			imapAddr := net.JoinHostPort(constants.Host, fmt.Sprint(ports.FindFreePortFrom(1243)))
+			smtpAddr := net.JoinHostPort(constants.Host, fmt.Sprint(ports.FindFreePortFrom(1125)))
+
+			// Listen on additional addresses.
+			require.NoError(t, bridge.SetIMAPListeners(ctx, []vault.ListenerSpec{{Address: imapAddr}}))
+			require.NoError(t, bridge.SetSMTPListeners(ctx, []vault.ListenerSpec{{Address: smtpAddr}}))
+
+			// Both the usual and the additional addresses accept connections.
+			for _, addr := range []string{imapAddr, net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetIMAPPort()))} {
+				imapClient, err := eventuallyDial(addr)
+				require.NoError(t, err)
+				require.NoError(t, imapClient.Logout())
+			}
+
+			for _, addr := range []string{smtpAddr, net.JoinHostPort(constants.Host, fmt.Sprint(bridge.GetSMTPPort()))} {
+				smtpClient, err := smtp.Dial(addr)
+				require.NoError(t, err)
+				require.NoError(t, smtpClient.Quit())
+			}
+
+			// Stop listening on the additional addresses.
+			require.NoError(t, bridge.SetIMAPListeners(ctx, nil))
+			require.NoError(t, bridge.SetSMTPListeners(ctx, nil))
+
+			_, err = net.Dial("tcp", imapAddr)
+			require.Error(t, err)
+
+			_, err = net.Dial("tcp", smtpAddr)
+			require.Error(t, err)
+		})
+	})
+}
+
+func TestServerManager_UnixSockets(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, mocks *bridge.Mocks) {
+			imapWaiter := waitForIMAPServerReady(bridge)
+			defer imapWaiter.Done()
+
+			smtpWaiter := waitForSMTPServerReady(bridge)
+			defer smtpWaiter.Done()
+
+			_, err := bridge.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			imapWaiter.Wait()
+			smtpWaiter.Wait()
+
+			// Unix sockets are disabled by default.
+			imapPath, err := bridge.GetIMAPSocketPath()
+			require.NoError(t, err)
+			require.Empty(t, imapPath)
+
+			require.NoError(t, bridge.SetUnixSockets(ctx, true))
+
+			imapPath, err = bridge.GetIMAPSocketPath()
+			require.NoError(t, err)
+
+			smtpPath, err := bridge.GetSMTPSocketPath()
+			require.NoError(t, err)
+
+			// Only the user can connect to the sockets.
+			for _, path := range []string{imapPath, smtpPath} {
+				info, err := os.Stat(path)
+				require.NoError(t, err)
+				require.Equal(t, os.ModeSocket|0o600, info.Mode()&(os.ModeSocket|os.ModePerm))
+			}
+
+			imapConn, err := net.Dial("unix", imapPath)
+			require.NoError(t, err)
+
+			imapClient, err := client.New(imapConn)
+			require.NoError(t, err)
+			require.NoError(t, imapClient.Logout())
+
+			smtpConn, err := net.Dial("unix", smtpPath)
+			require.NoError(t, err)
+
+			smtpClient, err := smtp.NewClient(smtpConn, constants.Host)
+			require.NoError(t, err)
+			require.NoError(t, smtpClient.Quit())
+
+			// The sockets are removed once disabled.
+			require.NoError(t, bridge.SetUnixSockets(ctx, false))
+
+			require.NoFileExists(t, imapPath)
+			require.NoFileExists(t, smtpPath)
+		})
+	})
+}
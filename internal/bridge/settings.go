@@ -24,10 +24,13 @@ import (
 	"os"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 func (bridge *Bridge) GetKeychainApp() (string, error) {
@@ -39,15 +42,52 @@ func (bridge *Bridge) GetKeychainApp() (string, error) {
 	return vault.GetHelper(vaultDir)
 }
 
+// SetKeychainApp sets the keychain helper in which the vault key is stored, moving the key to it.
+// If the key can't be moved, the previous keychain is still used.
 func (bridge *Bridge) SetKeychainApp(helper string) error {
 	vaultDir, err := bridge.locator.ProvideSettingsPath()
 	if err != nil {
 		return err
 	}
 
+	oldHelper, err := vault.GetHelper(vaultDir)
+	if err != nil {
+		return fmt.Errorf("could not get keychain helper: %w", err)
+	}
+
+	// There is no key to move if the keychain resolves to the same helper, or if the vault key couldn't be loaded.
+	if resolveKeychainHelper(oldHelper) == resolveKeychainHelper(helper) || bridge.isVaultInsecure(vaultDir) {
+		if err := vault.SetHelper(vaultDir, helper); err != nil {
+			return err
+		}
+	} else {
+		oldKC, err := keychain.NewKeychain(oldHelper, constants.KeyChainName)
+		if err != nil {
+			return fmt.Errorf("could not open current keychain: %w", err)
+		}
+
+		newKC, err := keychain.NewKeychain(helper, constants.KeyChainName)
+		if err != nil {
+			return fmt.Errorf("could not open new keychain: %w", err)
+		}
+
+		if err := vault.MigrateVaultKey(vaultDir, oldKC, newKC, helper); err != nil {
+			return fmt.Errorf("failed to move vault key to new keychain: %w", err)
+		}
+	}
+
 	bridge.heartbeat.SetKeyChainPref(helper)
 
-	return vault.SetHelper(vaultDir, helper)
+	return nil
+}
+
+// resolveKeychainHelper returns the keychain helper used for the given one, which falls back to the default one.
+func resolveKeychainHelper(helper string) string {
+	if _, ok := keychain.Helpers[helper]; !ok {
+		return keychain.DefaultHelper
+	}
+
+	return helper
 }
 
 func (bridge *Bridge) GetIMAPPort() int {
@@ -122,6 +162,76 @@ func (bridge *Bridge) SetSMTPSSL(ctx context.Context, newSSL bool) error {
 	return bridge.restartSMTP(ctx)
 }
 
+func (bridge *Bridge) GetIMAPListeners() []vault.ListenerSpec {
+	return bridge.vault.GetIMAPListeners()
+}
+
+// SetIMAPListeners sets the addresses the IMAP server listens on besides the IMAP port on localhost,
+// and restarts the IMAP server to listen on them.
+func (bridge *Bridge) SetIMAPListeners(ctx context.Context, listeners []vault.ListenerSpec) error {
+	if slices.Equal(listeners, bridge.vault.GetIMAPListeners()) {
+		return nil
+	}
+
+	if err := bridge.vault.SetIMAPListeners(listeners); err != nil {
+		return err
+	}
+
+	return bridge.restartIMAP(ctx)
+}
+
+func (bridge *Bridge) GetSMTPListeners() []vault.ListenerSpec {
+	return bridge.vault.GetSMTPListeners()
+}
+
+// SetSMTPListeners sets the addresses the SMTP server listens on besides the SMTP port on localhost,
+// and restarts the SMTP server to listen on them.
+func (bridge *Bridge) SetSMTPListeners(ctx context.Context, listeners []vault.ListenerSpec) error {
+	if slices.Equal(listeners, bridge.vault.GetSMTPListeners()) {
+		return nil
+	}
+
+	if err := bridge.vault.SetSMTPListeners(listeners); err != nil {
+		return err
+	}
+
+	return bridge.restartSMTP(ctx)
+}
+
+func (bridge *Bridge) GetUnixSockets() bool {
+	return bridge.vault.GetUnixSockets()
+}
+
+// SetUnixSockets sets whether the IMAP and SMTP servers also listen on Unix sockets in the user's runtime directory,
+// and restarts both servers accordingly. The gRPC service picks the setting up the next time it starts.
+func (bridge *Bridge) SetUnixSockets(ctx context.Context, unixSockets bool) error {
+	if unixSockets == bridge.vault.GetUnixSockets() {
+		return nil
+	}
+
+	if err := bridge.vault.SetUnixSockets(unixSockets); err != nil {
+		return err
+	}
+
+	if err := bridge.restartIMAP(ctx); err != nil {
+		return err
+	}
+
+	return bridge.restartSMTP(ctx)
+}
+
+// GetIMAPSocketPath returns the path of the Unix socket the IMAP server listens on,
+// or an empty string if Unix sockets are disabled.
+func (bridge *Bridge) GetIMAPSocketPath() (string, error) {
+	return bridge.getSocketPath(imapSocketName)
+}
+
+// GetSMTPSocketPath returns the path of the Unix socket the SMTP server listens on,
+// or an empty string if Unix sockets are disabled.
+func (bridge *Bridge) GetSMTPSocketPath() (string, error) {
+	return bridge.getSocketPath(smtpSocketName)
+}
+
 func (bridge *Bridge) GetGluonCacheDir() string {
 	return bridge.vault.GetGluonCacheDir()
 }
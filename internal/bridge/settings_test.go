@@ -25,6 +25,10 @@ import (
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/go-proton-api/server"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	dockerCredentials "github.com/docker/docker-credential-helpers/credentials"
 	"github.com/stretchr/testify/require"
 )
 
@@ -166,3 +170,55 @@ func TestBridge_Settings_FirstStart(t *testing.T) {
 		})
 	})
 }
+
+func TestBridge_Settings_KeychainApp(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		vaultDir, err := locator.ProvideSettingsPath()
+		require.NoError(t, err)
+
+		// The vault key is stored in a keychain.
+		oldHelper, newHelper := keychain.NewTestHelper(), keychain.NewTestHelper()
+
+		keychain.Helpers["mock"] = func(string) (dockerCredentials.Helper, error) { return oldHelper, nil }
+		keychain.Helpers["mock-new"] = func(string) (dockerCredentials.Helper, error) { return newHelper, nil }
+
+		defer delete(keychain.Helpers, "mock-new")
+
+		require.NoError(t, vault.SetHelper(vaultDir, "mock"))
+
+		oldKC, err := keychain.NewKeychain("mock", constants.KeyChainName)
+		require.NoError(t, err)
+		require.NoError(t, vault.SetVaultKey(oldKC, storeKey))
+
+		newKC, err := keychain.NewKeychain("mock-new", constants.KeyChainName)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// Switch to another keychain.
+			require.NoError(t, b.SetKeychainApp("mock-new"))
+
+			helper, err := b.GetKeychainApp()
+			require.NoError(t, err)
+			require.Equal(t, "mock-new", helper)
+		})
+
+		// The vault key was moved to it.
+		has, err := vault.HasVaultKey(oldKC)
+		require.NoError(t, err)
+		require.False(t, has)
+
+		key, err := vault.GetVaultKey(newKC)
+		require.NoError(t, err)
+		require.Equal(t, storeKey, key)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// Switching back fails if the key was lost meanwhile, and the keychain is left unchanged.
+			require.NoError(t, newKC.Delete("bridge-vault-key"))
+			require.ErrorIs(t, b.SetKeychainApp("mock"), vault.ErrNoVaultKey)
+
+			helper, err := b.GetKeychainApp()
+			require.NoError(t, err)
+			require.Equal(t, "mock-new", helper)
+		})
+	})
+}
@@ -23,11 +23,18 @@ import (
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
 	"github.com/emersion/go-sasl"
 	"github.com/emersion/go-smtp"
 	"github.com/sirupsen/logrus"
 )
 
+// smtpMaxMessageBytes is the size of the largest message accepted over SMTP, advertised with the SIZE extension.
+// The API accepts at most 25 MB of attachments, which grow by a third once base64-encoded.
+// Once authenticated, messages are further limited by the space left in the sender's account, which is advertised
+// instead to clients sending EHLO again.
+const smtpMaxMessageBytes = 36 << 20
+
 func (bridge *Bridge) restartSMTP(ctx context.Context) error {
 	return bridge.serverManager.RestartSMTP(ctx)
 }
@@ -41,8 +48,12 @@ func newSMTPServer(bridge *Bridge, tlsConfig *tls.Config, logSMTP bool) *smtp.Se
 	smtpServer.Domain = constants.Host
 	smtpServer.AllowInsecureAuth = true
 	smtpServer.MaxLineLength = 1 << 16
+	smtpServer.MaxMessageBytes = smtpMaxMessageBytes
 	smtpServer.ErrorLog = logging.NewSMTPLogger()
 
+	// Delivery status notifications are added to the sender's inbox when sending fails (RFC 3461).
+	smtpServer.EnableDSN = true
+
 	// go-smtp suppors SASL PLAIN but not LOGIN. We need to add LOGIN support ourselves.
 	smtpServer.EnableAuth(sasl.Login, func(conn *smtp.Conn) sasl.Server {
 		return sasl.NewLoginServer(func(username, password string) error {
@@ -50,6 +61,23 @@ func newSMTPServer(bridge *Bridge, tlsConfig *tls.Config, logSMTP bool) *smtp.Se
 		})
 	})
 
+	// With SCRAM, clients prove that they know the password without sending it, which matters when TLS is off.
+	smtpServer.EnableAuth(scram.SHA256, func(conn *smtp.Conn) sasl.Server {
+		return conn.Session().(*smtpSession).newSCRAMServer(conn, false) //nolint:forcetypeassert
+	})
+
+	smtpServer.EnableAuth(scram.SHA256Plus, func(conn *smtp.Conn) sasl.Server {
+		return conn.Session().(*smtpSession).newSCRAMServer(conn, true) //nolint:forcetypeassert
+	})
+
+	// Clients presenting a certificate which can be used instead of a password don't need to send one.
+	// The IMAP server doesn't offer it yet.
+	smtpServer.EnableAuth(sasl.External, func(conn *smtp.Conn) sasl.Server {
+		return sasl.NewExternalServer(func(identity string) error {
+			return conn.Session().(*smtpSession).authExternal(identity) //nolint:forcetypeassert
+		})
+	})
+
 	if logSMTP {
 		log := logrus.WithField("protocol", "SMTP")
 		log.Warning("================================================")
@@ -19,16 +19,60 @@ package bridge
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/emersion/go-sasl"
 	"github.com/emersion/go-smtp"
 	"github.com/sirupsen/logrus"
 )
 
+var errQuotaExceeded = &smtp.SMTPError{ //nolint:gochecknoglobals
+	Code:         552,
+	EnhancedCode: smtp.EnhancedCode{5, 2, 2},
+	Message:      "Message exceeds the space left in the account",
+}
+
+var errSMTPNotAllowed = &smtp.SMTPError{ //nolint:gochecknoglobals
+	Code:         535,
+	EnhancedCode: smtp.EnhancedCode{5, 7, 8},
+	Message:      "The credentials can't be used to send messages",
+}
+
+var errAuthThrottled = &smtp.SMTPError{ //nolint:gochecknoglobals
+	Code:         454,
+	EnhancedCode: smtp.EnhancedCode{4, 7, 0},
+	Message:      "Too many failed authentication attempts, try again later",
+}
+
+var errClientCertRequired = &smtp.SMTPError{ //nolint:gochecknoglobals
+	Code:         530,
+	EnhancedCode: smtp.EnhancedCode{5, 7, 0},
+	Message:      "A client certificate mapped to the account is required",
+}
+
+var errClientCertNotAccepted = &smtp.SMTPError{ //nolint:gochecknoglobals
+	Code:         535,
+	EnhancedCode: smtp.EnhancedCode{5, 7, 8},
+	Message:      "No client certificate which can be used to authenticate was presented",
+}
+
+var errSenderNotAllowed = &smtp.SMTPError{ //nolint:gochecknoglobals
+	Code:         550,
+	EnhancedCode: smtp.EnhancedCode{5, 7, 1},
+	Message:      "The credentials can't be used to send from this address",
+}
+
 type smtpBackend struct {
 	*Bridge
 }
@@ -36,33 +80,75 @@ type smtpBackend struct {
 type smtpSession struct {
 	*Bridge
 
+	conn *smtp.Conn
+
+	// source is the address the client connected from, against which failed authentication attempts are counted.
+	source string
+
 	userID string
 	authID string
+	scope  vault.AppPasswordScope
 
 	from string
 	to   []string
+	dsn  user.DSN
 }
 
-func (be *smtpBackend) NewSession(*smtp.Conn) (smtp.Session, error) {
-	return &smtpSession{Bridge: be.Bridge}, nil
+func (be *smtpBackend) NewSession(c *smtp.Conn) (smtp.Session, error) {
+	return &smtpSession{Bridge: be.Bridge, conn: c, source: getAuthSource(c.Conn().RemoteAddr())}, nil
 }
 
 func (s *smtpSession) AuthPlain(username, password string) error {
+	if !s.authLimiter.allow(s.source, username) {
+		logrus.WithFields(logrus.Fields{
+			"username": username,
+			"source":   s.source,
+			"pkg":      "smtp",
+		}).Warn("Too many failed authentication attempts, rejecting.")
+
+		return errAuthThrottled
+	}
+
+	if err := s.checkClientCert(""); err != nil {
+		return err
+	}
+
+	err := s.authPlain(username, password)
+
+	switch {
+	case err == nil:
+		s.authLimiter.succeed(s.source, username)
+
+	// Valid credentials which can't be used over SMTP are not a failed attempt.
+	case !errors.Is(err, errSMTPNotAllowed):
+		s.authLimiter.fail(s.source, username)
+	}
+
+	return err
+}
+
+func (s *smtpSession) authPlain(username, password string) error {
 	return safe.RLockRet(func() error {
 		for _, user := range s.users {
-			addrID, err := user.CheckAuth(username, []byte(password))
+			addrID, scope, err := user.CheckAuth(username, []byte(password))
 			if err != nil {
 				continue
 			}
 
-			s.userID = user.ID()
-			s.authID = addrID
+			if scope.SMTP == vault.SMTPDenied {
+				logrus.WithFields(logrus.Fields{
+					"username": username,
+					"pkg":      "smtp",
+				}).Error("The credentials can't be used over SMTP.")
+
+				return errSMTPNotAllowed
+			}
 
-			if strings.Contains(s.Bridge.GetCurrentUserAgent(), useragent.DefaultUserAgent) {
-				s.Bridge.setUserAgent(useragent.UnknownClient, useragent.DefaultVersion)
+			if err := s.checkClientCert(user.ID()); err != nil {
+				return err
 			}
 
-			user.SendConfigStatusSuccess(context.Background())
+			s.setAuthenticated(user, addrID, scope)
 
 			return nil
 		}
@@ -84,24 +170,273 @@ func (s *smtpSession) AuthPlain(username, password string) error {
 	}, s.usersLock)
 }
 
+// setAuthenticated records that the session has authenticated as the given address of the given user.
+func (s *smtpSession) setAuthenticated(user *user.User, addrID string, scope vault.AppPasswordScope) {
+	s.userID = user.ID()
+	s.authID = addrID
+	s.scope = scope
+
+	// Clients which send EHLO again once authenticated are told the size of the largest message the account can send.
+	s.conn.SetMaxMessageBytes(getSMTPMaxMessageBytes(user.GetSendLimit()))
+
+	if strings.Contains(s.Bridge.GetCurrentUserAgent(), useragent.DefaultUserAgent) {
+		s.Bridge.setUserAgent(useragent.UnknownClient, useragent.DefaultVersion)
+	}
+
+	user.SendConfigStatusSuccess(context.Background())
+}
+
+// authExternal authenticates the session with the TLS client certificate presented by the client (SASL EXTERNAL),
+// if it is mapped to a user and can be used instead of a password.
+// The identity is the address to authenticate as; the user's primary address if empty.
+func (s *smtpSession) authExternal(identity string) error {
+	cert := s.getPeerCert()
+	if cert == nil || s.GetClientCertMode() == vault.ClientCertsOff {
+		return errClientCertNotAccepted
+	}
+
+	userID, clientCert, ok := s.findClientCert(cert)
+	if !ok || !clientCert.SkipPassword {
+		logrus.WithFields(logrus.Fields{
+			"subject": cert.Subject.String(),
+			"pkg":     "smtp",
+		}).Error("The client certificate can't be used to authenticate.")
+
+		return errClientCertNotAccepted
+	}
+
+	return safe.RLockRet(func() error {
+		user, ok := s.users[userID]
+		if !ok {
+			return errClientCertNotAccepted
+		}
+
+		if identity == "" {
+			emails := user.Emails()
+			if len(emails) == 0 {
+				return errClientCertNotAccepted
+			}
+
+			identity = emails[0]
+		}
+
+		addrID, scope, err := user.CheckExternalAuth(identity)
+		if err != nil {
+			return errSenderNotAllowed
+		}
+
+		s.setAuthenticated(user, addrID, scope)
+
+		return nil
+	}, s.usersLock)
+}
+
+// checkClientCert returns an error if client certificates are required
+// and the client hasn't presented one mapped to the given user, or to any user if empty.
+func (s *smtpSession) checkClientCert(userID string) error {
+	if !s.allowClientCert(s.conn.Conn().RemoteAddr(), s.getPeerCert(), userID) {
+		logrus.WithFields(logrus.Fields{
+			"source": s.source,
+			"pkg":    "smtp",
+		}).Error("No client certificate mapped to the account was presented.")
+
+		return errClientCertRequired
+	}
+
+	return nil
+}
+
+// getPeerCert returns the TLS client certificate presented by the client, if any.
+func (s *smtpSession) getPeerCert() *x509.Certificate {
+	state, ok := s.conn.TLSConnectionState()
+	if !ok || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+
+	return state.PeerCertificates[0]
+}
+
+// smtpSCRAMServer authenticates an SMTP session with SCRAM-SHA-256 or SCRAM-SHA-256-PLUS.
+type smtpSCRAMServer struct {
+	sasl.Server
+
+	session *smtpSession
+
+	username   string
+	userID     string
+	appPassIDs []string
+}
+
+// newSCRAMServer returns a SASL server for SCRAM-SHA-256, or SCRAM-SHA-256-PLUS if plus is set.
+func (s *smtpSession) newSCRAMServer(conn *smtp.Conn, plus bool) sasl.Server {
+	server := &smtpSCRAMServer{session: s}
+
+	var binding []byte
+
+	if state, ok := conn.TLSConnectionState(); ok {
+		binding = getChannelBinding(&state)
+	}
+
+	if plus {
+		server.Server = scram.NewPlusServer(binding, server.lookup, server.authenticate)
+	} else {
+		server.Server = scram.NewServer(binding, server.lookup, server.authenticate)
+	}
+
+	return server
+}
+
+func (server *smtpSCRAMServer) Next(response []byte) ([]byte, bool, error) {
+	challenge, done, err := server.Server.Next(response)
+
+	if errors.Is(err, scram.ErrInvalidProof) {
+		logrus.WithFields(logrus.Fields{
+			"username": server.username,
+			"pkg":      "smtp",
+		}).Error("Incorrect login credentials.")
+
+		server.session.authLimiter.fail(server.session.source, server.username)
+
+		return nil, false, fmt.Errorf("invalid username or password")
+	}
+
+	return challenge, done, err
+}
+
+// lookup returns the SCRAM credentials of the user with the given address.
+// Unknown addresses get credentials without any verifier, with which no client can authenticate.
+func (server *smtpSCRAMServer) lookup(username string) (scram.Credentials, error) {
+	server.username = username
+
+	if !server.session.authLimiter.allow(server.session.source, username) {
+		logrus.WithFields(logrus.Fields{
+			"username": username,
+			"source":   server.session.source,
+			"pkg":      "smtp",
+		}).Warn("Too many failed authentication attempts, rejecting.")
+
+		return scram.Credentials{}, errAuthThrottled
+	}
+
+	if err := server.session.checkClientCert(""); err != nil {
+		return scram.Credentials{}, err
+	}
+
+	return safe.RLockRetErr(func() (scram.Credentials, error) {
+		for _, user := range server.session.users {
+			if xslices.IndexFunc(user.Emails(), func(email string) bool { return strings.EqualFold(email, username) }) < 0 {
+				continue
+			}
+
+			credentials, appPassIDs, err := user.GetSCRAMCredentials()
+			if err != nil {
+				return scram.Credentials{}, err
+			}
+
+			server.userID = user.ID()
+			server.appPassIDs = appPassIDs
+
+			return credentials, nil
+		}
+
+		return scram.Credentials{Salt: scram.NewSalt(), Iterations: scram.Iterations}, nil
+	}, server.session.usersLock)
+}
+
+// authenticate authenticates the session once the client has proved that it knows one of the user's passwords.
+func (server *smtpSCRAMServer) authenticate(username string, verifier int) error {
+	return safe.RLockRet(func() error {
+		user, ok := server.session.users[server.userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		addrID, scope, err := user.CheckSCRAMAuth(username, server.appPassIDs[verifier])
+		if err != nil {
+			server.session.authLimiter.fail(server.session.source, username)
+			return fmt.Errorf("invalid username or password")
+		}
+
+		if scope.SMTP == vault.SMTPDenied {
+			return errSMTPNotAllowed
+		}
+
+		if err := server.session.checkClientCert(user.ID()); err != nil {
+			return err
+		}
+
+		server.session.authLimiter.succeed(server.session.source, username)
+		server.session.setAuthenticated(user, addrID, scope)
+
+		return nil
+	}, server.session.usersLock)
+}
+
+// getChannelBinding returns the tls-exporter channel binding data of the TLS connection with the given state (RFC 9266),
+// or nil if it's nil or the data can't be exported, as with TLS 1.2 without extended master secret.
+func getChannelBinding(state *tls.ConnectionState) []byte {
+	if state == nil {
+		return nil
+	}
+
+	binding, err := state.ExportKeyingMaterial(scram.ChannelBindingLabel, nil, scram.ChannelBindingLength)
+	if err != nil {
+		return nil
+	}
+
+	return binding
+}
+
 func (s *smtpSession) Reset() {
 	s.from = ""
 	s.to = nil
+	s.dsn = user.DSN{}
 }
 
 func (s *smtpSession) Logout() error {
 	s.Reset()
+
 	return nil
 }
 
-func (s *smtpSession) Mail(from string, _ *smtp.MailOptions) error {
+// Mail sets the return path of the message and checks its declared size, if any.
+// It also keeps the RET and ENVID parameters of the DSN extension (RFC 3461), which the SMTP server has validated.
+func (s *smtpSession) Mail(from string, opts *smtp.MailOptions) error {
+	if opts != nil {
+		// Reject messages which are declared too large for the account before they are transferred.
+		if opts.Size > 0 {
+			if err := s.checkMessageSize(opts.Size); err != nil {
+				return err
+			}
+		}
+
+		s.dsn.ReturnFull = opts.Return == smtp.DSNReturnFull
+		s.dsn.EnvelopeID = opts.EnvelopeID
+	}
+
 	s.from = from
+
 	return nil
 }
 
-func (s *smtpSession) Rcpt(to string) error {
-	if len(to) > 0 {
-		s.to = append(s.to, to)
+// Rcpt adds a recipient to the message, along with its NOTIFY and ORCPT parameters of the DSN extension (RFC 3461).
+func (s *smtpSession) Rcpt(address string, opts *smtp.RcptOptions) error {
+	if len(address) == 0 {
+		return nil
+	}
+
+	if err := s.checkRecipient(address); err != nil {
+		return err
+	}
+
+	s.to = append(s.to, address)
+
+	if params := newDSNRecipient(opts); params != (user.DSNRecipient{}) {
+		if s.dsn.Recipients == nil {
+			s.dsn.Recipients = make(map[string]user.DSNRecipient)
+		}
+
+		s.dsn.Recipients[address] = params
 	}
 
 	return nil
@@ -114,12 +449,126 @@ func (s *smtpSession) Data(r io.Reader) error {
 			return ErrNoSuchUser
 		}
 
-		return user.SendMail(s.authID, s.from, s.to, r)
+		return user.SendMail(s.authID, s.scope, s.from, s.to, s.dsn, r)
 	}, s.usersLock)
 
 	if err != nil {
 		logrus.WithField("pkg", "smtp").WithError(err).Error("Send mail failed.")
 	}
 
+	// The SMTP server only recognizes its own errors if they are not wrapped.
+	if smtpErr := new(smtp.SMTPError); errors.As(err, &smtpErr) {
+		return smtpErr
+	}
+
+	if errors.Is(err, user.ErrQuotaExceeded) {
+		return errQuotaExceeded
+	}
+
+	if errors.Is(err, user.ErrSenderNotAllowed) {
+		return errSenderNotAllowed
+	}
+
 	return err
 }
+
+// getSMTPMaxMessageBytes returns the size of the largest message accepted from an account with the given send limit,
+// as returned by User.GetSendLimit.
+func getSMTPMaxMessageBytes(sendLimit int) int {
+	if sendLimit < 0 || sendLimit > smtpMaxMessageBytes {
+		return smtpMaxMessageBytes
+	}
+
+	// The SIZE extension can't advertise that no message is accepted; such messages are rejected with MAIL or DATA.
+	if sendLimit == 0 {
+		return 1
+	}
+
+	return sendLimit
+}
+
+// checkMessageSize rejects messages larger than the space left in the account.
+func (s *smtpSession) checkMessageSize(size int) error {
+	limit := safe.RLockRet(func() int {
+		user, ok := s.users[s.userID]
+		if !ok {
+			return -1
+		}
+
+		return user.GetSendLimit()
+	}, s.usersLock)
+
+	if limit >= 0 && size > limit {
+		return errQuotaExceeded
+	}
+
+	return nil
+}
+
+// checkRecipient rejects recipients to which the message certainly can't be sent, with a matching SMTP error.
+func (s *smtpSession) checkRecipient(address string) error {
+	err := safe.RLockRet(func() error {
+		user, ok := s.users[s.userID]
+		if !ok {
+			return nil
+		}
+
+		return user.CheckRecipient(address)
+	}, s.usersLock)
+
+	switch {
+	case err == nil:
+		return nil
+
+	case errors.Is(err, user.ErrInvalidRecipient):
+		return &smtp.SMTPError{
+			Code:         501,
+			EnhancedCode: smtp.EnhancedCode{5, 1, 3},
+			Message:      fmt.Sprintf("Bad recipient address syntax <%v>", address),
+		}
+
+	case errors.Is(err, user.ErrNoSuchRecipient):
+		return &smtp.SMTPError{
+			Code:         550,
+			EnhancedCode: smtp.EnhancedCode{5, 1, 1},
+			Message:      fmt.Sprintf("No such recipient <%v>", address),
+		}
+
+	case errors.Is(err, user.ErrRecipientDisabled):
+		return &smtp.SMTPError{
+			Code:         550,
+			EnhancedCode: smtp.EnhancedCode{5, 2, 1},
+			Message:      fmt.Sprintf("Recipient address is disabled <%v>", address),
+		}
+
+	default:
+		return err
+	}
+}
+
+// newDSNRecipient returns the delivery status notification parameters given for a recipient with the RCPT command.
+func newDSNRecipient(opts *smtp.RcptOptions) user.DSNRecipient {
+	var params user.DSNRecipient
+
+	for _, notify := range opts.Notify {
+		switch notify {
+		case smtp.DSNNotifyFailure:
+			params.Notify |= user.DSNNotifyFailure
+
+		case smtp.DSNNotifyDelayed:
+			params.Notify |= user.DSNNotifyDelay
+
+		case smtp.DSNNotifySuccess:
+			params.Notify |= user.DSNNotifySuccess
+
+		case smtp.DSNNotifyNever:
+			params.Notify |= user.DSNNotifyNever
+		}
+	}
+
+	if opts.OriginalRecipientType != "" {
+		params.OriginalRecipient = strings.ToLower(string(opts.OriginalRecipientType)) + ";" + opts.OriginalRecipient
+	}
+
+	return params
+}
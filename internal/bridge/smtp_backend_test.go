@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDSNRecipient(t *testing.T) {
+	require.Equal(t, user.DSNRecipient{}, newDSNRecipient(&smtp.RcptOptions{}))
+
+	require.Equal(t, user.DSNRecipient{
+		Notify:            user.DSNNotifyFailure | user.DSNNotifyDelay,
+		OriginalRecipient: "rfc822;Recipient@pm.me",
+	}, newDSNRecipient(&smtp.RcptOptions{
+		Notify:                []smtp.DSNNotify{smtp.DSNNotifyFailure, smtp.DSNNotifyDelayed},
+		OriginalRecipientType: smtp.DSNAddressTypeRFC822,
+		OriginalRecipient:     "Recipient@pm.me",
+	}))
+
+	require.Equal(t, user.DSNRecipient{Notify: user.DSNNotifyNever}, newDSNRecipient(&smtp.RcptOptions{
+		Notify: []smtp.DSNNotify{smtp.DSNNotifyNever},
+	}))
+}
+
+func TestGetSMTPMaxMessageBytes(t *testing.T) {
+	require.Equal(t, smtpMaxMessageBytes, getSMTPMaxMessageBytes(-1))
+	require.Equal(t, smtpMaxMessageBytes, getSMTPMaxMessageBytes(smtpMaxMessageBytes+1))
+	require.Equal(t, 1<<20, getSMTPMaxMessageBytes(1<<20))
+	require.Equal(t, 1, getSMTPMaxMessageBytes(0))
+}
@@ -17,10 +17,181 @@
 
 package bridge
 
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/sirupsen/logrus"
+)
+
+// TLSCertCheckPeriod is how often the TLS certificate files are checked for changes and the certificate for its expiry.
+var TLSCertCheckPeriod = time.Minute // nolint:gochecknoglobals,revive
+
 func (bridge *Bridge) GetBridgeTLSCert() ([]byte, []byte) {
 	return bridge.vault.GetBridgeTLSCert()
 }
 
+// GetBridgeTLSCertPath returns the paths to the PEM files the TLS certificate and key are read from, if any.
+func (bridge *Bridge) GetBridgeTLSCertPath() (string, string) {
+	return bridge.vault.GetBridgeTLSCertPath()
+}
+
+// SetBridgeTLSCertPath makes the IMAP and SMTP servers use the TLS certificate and key read from the given PEM files.
+// The files are watched, so that a renewed certificate is used as soon as they change, without restarting bridge.
 func (bridge *Bridge) SetBridgeTLSCertPath(certPath, keyPath string) error {
-	return bridge.vault.SetBridgeTLSCertPath(certPath, keyPath)
+	if err := bridge.vault.SetBridgeTLSCertPath(certPath, keyPath); err != nil {
+		return err
+	}
+
+	if err := bridge.reloadTLSCert(); err != nil {
+		return err
+	}
+
+	bridge.goCheckTLSCert()
+
+	return nil
+}
+
+// ClearBridgeTLSCertPath stops reading the TLS certificate and key from files, to use the one stored in the vault again.
+func (bridge *Bridge) ClearBridgeTLSCertPath() error {
+	if err := bridge.vault.ClearBridgeTLSCertPath(); err != nil {
+		return err
+	}
+
+	if err := bridge.reloadTLSCert(); err != nil {
+		return err
+	}
+
+	bridge.goCheckTLSCert()
+
+	return nil
+}
+
+// GetTLSCertHosts returns the hostnames and IP addresses the generated TLS certificate is valid for.
+func (bridge *Bridge) GetTLSCertHosts() []string {
+	return bridge.vault.GetBridgeTLSCertHosts()
+}
+
+// GetTLSKeyType returns the type of the private key of the generated TLS certificate.
+func (bridge *Bridge) GetTLSKeyType() certs.KeyType {
+	return bridge.vault.GetBridgeTLSKeyType()
+}
+
+// RegenerateTLSCert generates a new TLS certificate for the given hostnames and IP addresses, with a key of the given type,
+// which replaces the current one, including an imported one. The IMAP and SMTP servers use it without being restarted.
+// As the new certificate isn't trusted by the system, it must be installed again.
+// Client certificates are issued by a CA of their own, so they remain trusted.
+func (bridge *Bridge) RegenerateTLSCert(hosts []string, keyType certs.KeyType) error {
+	template, err := certs.NewTLSTemplateForHosts(hosts)
+	if err != nil {
+		return err
+	}
+
+	certPEM, keyPEM, err := certs.GenerateCertWithKeyType(template, keyType)
+	if err != nil {
+		return fmt.Errorf("failed to generate TLS certificate: %w", err)
+	}
+
+	if err := bridge.vault.SetBridgeTLSCertOptions(hosts, keyType); err != nil {
+		return err
+	}
+
+	if err := bridge.vault.SetBridgeTLSCertKey(certPEM, keyPEM); err != nil {
+		return err
+	}
+
+	if err := bridge.vault.ClearBridgeTLSCertPath(); err != nil {
+		return err
+	}
+
+	if err := bridge.vault.SetCertsInstalled(false); err != nil {
+		return err
+	}
+
+	if err := bridge.reloadTLSCert(); err != nil {
+		return err
+	}
+
+	bridge.goCheckTLSCert()
+
+	return nil
+}
+
+// reloadTLSCert loads the TLS certificate from the vault again, to serve it from the next TLS handshake on.
+func (bridge *Bridge) reloadTLSCert() error {
+	cert, err := loadTLSCert(bridge.vault)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	safe.Lock(func() {
+		bridge.tlsCert = cert
+	}, bridge.tlsCertLock)
+
+	logrus.Info("TLS certificate reloaded")
+
+	return nil
+}
+
+// getTLSCert returns the TLS certificate to serve to IMAP and SMTP clients.
+func (bridge *Bridge) getTLSCert(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return safe.RLockRet(func() *tls.Certificate {
+		return bridge.tlsCert
+	}, bridge.tlsCertLock), nil
+}
+
+// newTLSCertChecker returns a function which reloads the TLS certificate when the files it is read from change,
+// and publishes an event when the served certificate is about to expire, once per certificate.
+func (bridge *Bridge) newTLSCertChecker() func(context.Context) {
+	var warned *tls.Certificate
+
+	return func(context.Context) {
+		if certPath, keyPath := bridge.vault.GetBridgeTLSCertPath(); certPath != "" && keyPath != "" {
+			if err := bridge.reloadTLSCertFiles(certPath, keyPath); err != nil {
+				logrus.WithError(err).Warn("Failed to reload TLS certificate from files, still using the previous one")
+			}
+		}
+
+		cert, err := bridge.getTLSCert(nil)
+		if err != nil || cert == warned {
+			return
+		}
+
+		if err := certs.CheckExpiry(cert.Leaf); err != nil {
+			logrus.WithField("notAfter", cert.Leaf.NotAfter).Warn("TLS certificate expires soon")
+			bridge.publish(events.TLSCertExpiresSoon{NotAfter: cert.Leaf.NotAfter})
+			warned = cert
+		}
+	}
+}
+
+// reloadTLSCertFiles serves the TLS certificate read from the given files if it differs from the one currently served.
+func (bridge *Bridge) reloadTLSCertFiles(certPath, keyPath string) error {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return err
+	}
+
+	if cur, _ := bridge.getTLSCert(nil); bytes.Equal(cur.Certificate[0], cert.Certificate[0]) {
+		return nil
+	}
+
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return err
+	}
+
+	safe.Lock(func() {
+		bridge.tlsCert = &cert
+	}, bridge.tlsCertLock)
+
+	logrus.WithField("notAfter", cert.Leaf.NotAfter).Info("TLS certificate changed on disk, reloaded")
+
+	return nil
 }
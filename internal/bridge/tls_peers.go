@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"sync"
+)
+
+// tlsPeers records the certificates presented by TLS clients, keyed by the connection they were presented on,
+// as gluon doesn't expose the TLS state of the IMAP sessions. The connections are accepted from listeners wrapped
+// with listen, so that their certificate is forgotten once they are closed, whether or not the client authenticated.
+type tlsPeers struct {
+	certs    map[net.Conn]*x509.Certificate
+	sessions map[int]net.Conn
+	lock     sync.Mutex
+}
+
+func newTLSPeers() *tlsPeers {
+	return &tlsPeers{
+		certs:    make(map[net.Conn]*x509.Certificate),
+		sessions: make(map[int]net.Conn),
+	}
+}
+
+// listen wraps the given listener so that the certificates presented on its connections are removed when they are closed.
+func (p *tlsPeers) listen(listener net.Listener) net.Listener {
+	return &tlsPeerListener{Listener: listener, peers: p}
+}
+
+func (p *tlsPeers) setCert(conn net.Conn, cert *x509.Certificate) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.certs[conn] = cert
+}
+
+func (p *tlsPeers) removeCert(conn net.Conn) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.certs, conn)
+}
+
+// addIMAPSession records the connection of the given IMAP session. With implicit TLS, the session is served
+// on the TLS connection, while the certificate is recorded for the connection underneath it.
+func (p *tlsPeers) addIMAPSession(sessionID int, conn net.Conn) {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		conn = tlsConn.NetConn()
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.sessions[sessionID] = conn
+}
+
+func (p *tlsPeers) removeIMAPSession(sessionID int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.sessions, sessionID)
+}
+
+// getIMAPSession returns the remote address of the given IMAP session and the certificate its client presented, if any.
+func (p *tlsPeers) getIMAPSession(sessionID int) (net.Addr, *x509.Certificate) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	conn, ok := p.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+
+	return conn.RemoteAddr(), p.certs[conn]
+}
+
+// tlsPeerListener is a listener whose connections remove their certificate from the TLS peers when closed.
+type tlsPeerListener struct {
+	net.Listener
+
+	peers *tlsPeers
+}
+
+func (l *tlsPeerListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &tlsPeerConn{Conn: conn, peers: l.peers}, nil
+}
+
+type tlsPeerConn struct {
+	net.Conn
+
+	peers *tlsPeers
+}
+
+func (c *tlsPeerConn) Close() error {
+	c.peers.removeCert(c)
+
+	return c.Conn.Close()
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSPeers_UnixSockets(t *testing.T) {
+	peers := newTLSPeers()
+
+	listener, err := net.Listen("unix", filepath.Join(t.TempDir(), "imap.sock"))
+	require.NoError(t, err)
+
+	listener = peers.listen(listener)
+	defer func() { _ = listener.Close() }()
+
+	accept := func() net.Conn {
+		client, err := net.Dial("unix", listener.Addr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = client.Close() })
+
+		conn, err := listener.Accept()
+		require.NoError(t, err)
+
+		return conn
+	}
+
+	// Clients connected over Unix sockets share the same remote address, but not their certificate.
+	conn1, conn2 := accept(), accept()
+	require.Equal(t, conn1.RemoteAddr().String(), conn2.RemoteAddr().String())
+
+	cert1, cert2 := &x509.Certificate{}, &x509.Certificate{}
+
+	peers.setCert(conn1, cert1)
+	peers.setCert(conn2, cert2)
+
+	// With implicit TLS, the session is served on the TLS connection.
+	peers.addIMAPSession(1, conn1)
+	peers.addIMAPSession(2, tls.Server(conn2, &tls.Config{})) //nolint:gosec
+
+	remoteAddr, cert := peers.getIMAPSession(1)
+	require.Equal(t, "unix", remoteAddr.Network())
+	require.Same(t, cert1, cert)
+
+	_, cert = peers.getIMAPSession(2)
+	require.Same(t, cert2, cert)
+
+	// The certificate is forgotten once the connection is closed, even if no session was added for it.
+	conn3 := accept()
+	peers.setCert(conn3, &x509.Certificate{})
+
+	require.NoError(t, conn1.Close())
+	require.NoError(t, conn3.Close())
+	require.Len(t, peers.certs, 1)
+
+	_, cert = peers.getIMAPSession(1)
+	require.Nil(t, cert)
+
+	// Removing a session doesn't forget the certificate of its connection, which is still open.
+	peers.removeIMAPSession(2)
+	require.Len(t, peers.certs, 1)
+	require.NotContains(t, peers.sessions, 2)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_RegenerateTLSCert(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			_, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			// By default, the certificate is only valid for the default host.
+			require.Equal(t, []string{certs.DefaultHost}, b.GetTLSCertHosts())
+			require.Equal(t, certs.KeyTypeRSA, b.GetTLSKeyType())
+			require.Error(t, getServedTLSCert(t, b).VerifyHostname("bridge.local"))
+
+			// Invalid hosts are rejected.
+			require.ErrorIs(t, b.RegenerateTLSCert([]string{"bridge local"}, certs.KeyTypeECDSA), certs.ErrInvalidHost)
+
+			// Regenerate the certificate for other hosts with another key type.
+			require.NoError(t, b.RegenerateTLSCert([]string{"bridge.local", "::1"}, certs.KeyTypeEd25519))
+			require.Equal(t, []string{"bridge.local", "::1"}, b.GetTLSCertHosts())
+			require.Equal(t, certs.KeyTypeEd25519, b.GetTLSKeyType())
+
+			// The servers serve it without being restarted.
+			cert := getServedTLSCert(t, b)
+			require.NoError(t, cert.VerifyHostname("bridge.local"))
+			require.NoError(t, cert.VerifyHostname("::1"))
+			require.Equal(t, x509.Ed25519, cert.PublicKeyAlgorithm)
+
+			// It is the certificate stored in the vault.
+			certPEM, _ := b.GetBridgeTLSCert()
+			block, _ := pem.Decode(certPEM)
+			require.Equal(t, block.Bytes, cert.Raw)
+		})
+	})
+}
+
+func TestBridge_TLSCertPath(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			smtpWaiter := waitForSMTPServerReady(b)
+			defer smtpWaiter.Done()
+
+			_, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			smtpWaiter.Wait()
+
+			eventCh, done := b.GetEvents(events.TLSCertExpiresSoon{})
+			defer done()
+
+			certPath := filepath.Join(t.TempDir(), "cert.pem")
+			keyPath := filepath.Join(t.TempDir(), "key.pem")
+
+			// Import a certificate from files; it is served right away.
+			writeTLSCertFiles(t, certPath, keyPath, "first.local", time.Now().Add(365*24*time.Hour))
+			require.NoError(t, b.SetBridgeTLSCertPath(certPath, keyPath))
+			require.NoError(t, getServedTLSCert(t, b).VerifyHostname("first.local"))
+
+			// Renew the certificate in place; it is reloaded without restarting the servers.
+			notAfter := writeTLSCertFiles(t, certPath, keyPath, "second.local", time.Now().Add(7*24*time.Hour))
+			require.Eventually(t, func() bool {
+				return getServedTLSCert(t, b).VerifyHostname("second.local") == nil
+			}, 10*time.Second, 100*time.Millisecond)
+
+			// As it expires soon, an event is published.
+			event, ok := (<-eventCh).(events.TLSCertExpiresSoon)
+			require.True(t, ok)
+			require.True(t, event.NotAfter.Equal(notAfter))
+
+			// Invalid files are ignored, and the previous certificate is still served.
+			require.NoError(t, os.WriteFile(certPath, []byte("garbage"), 0o600))
+			time.Sleep(10 * bridge.TLSCertCheckPeriod)
+			require.NoError(t, getServedTLSCert(t, b).VerifyHostname("second.local"))
+
+			// Stop reading the files; the certificate stored in the vault is served again.
+			require.NoError(t, b.ClearBridgeTLSCertPath())
+			require.NoError(t, getServedTLSCert(t, b).VerifyHostname(certs.DefaultHost))
+		})
+	})
+}
+
+// getServedTLSCert returns the certificate the SMTP server of the given bridge presents to clients.
+func getServedTLSCert(t *testing.T, b *bridge.Bridge) *x509.Certificate {
+	smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+	require.NoError(t, err)
+	defer func() { _ = smtpClient.Close() }()
+
+	require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true})) //nolint:gosec
+
+	state, ok := smtpClient.TLSConnectionState()
+	require.True(t, ok)
+
+	return state.PeerCertificates[0]
+}
+
+// writeTLSCertFiles writes a new certificate for the given host, valid until the given time, and its key to the given files.
+func writeTLSCertFiles(t *testing.T, certPath, keyPath, host string, notAfter time.Time) time.Time {
+	template, err := certs.NewTLSTemplateForHosts([]string{host})
+	require.NoError(t, err)
+
+	template.NotAfter = notAfter.Truncate(time.Second)
+
+	certPEM, keyPEM, err := certs.GenerateCertWithKeyType(template, certs.KeyTypeECDSA)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(keyPath, keyPEM, 0o600))
+	require.NoError(t, os.WriteFile(certPath, certPEM, 0o600))
+
+	return template.NotAfter
+}
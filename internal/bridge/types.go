@@ -29,6 +29,7 @@ type Locator interface {
 	ProvideGluonCachePath() (string, error)
 	ProvideGluonDataPath() (string, error)
 	ProvideStatsPath() (string, error)
+	ProvideRuntimePath() (string, error)
 	GetLicenseFilePath() string
 	GetDependencyLicensesLink() string
 	Clear(...string) error
@@ -538,6 +538,8 @@ func (bridge *Bridge) addUserWithVault(
 		bridge.vault.GetMaxSyncMemory(),
 		statsPath,
 		bridge,
+		bridge.api,
+		imapAuthChecker{Bridge: bridge},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
 )
 
 func TestBridge_WithoutUsers(t *testing.T) {
@@ -376,6 +378,48 @@ func TestBridge_LoginDeleteRestart(t *testing.T) {
 	})
 }
 
+func TestBridge_DeleteGluonUsers(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		var userID, gluonCacheDir, gluonDataDir string
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, mocks *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID = must(b.LoginFull(ctx, username, password, nil, nil))
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			gluonCacheDir, gluonDataDir = b.GetGluonCacheDir(), must(b.GetGluonDataDir())
+		})
+
+		vaultDir, err := locator.ProvideSettingsPath()
+		require.NoError(t, err)
+
+		var gluonIDs []string
+
+		withVault(t, vaultDir, storeKey, func(v *vault.Vault) {
+			require.NoError(t, v.GetUser(userID, func(user *vault.User) {
+				gluonIDs = maps.Values(user.GetGluonIDs())
+			}))
+		})
+
+		require.NotEmpty(t, gluonIDs)
+
+		for _, gluonID := range gluonIDs {
+			require.DirExists(t, filepath.Join(bridge.ApplyGluonCachePathSuffix(gluonCacheDir), gluonID))
+			require.FileExists(t, filepath.Join(bridge.ApplyGluonConfigPathSuffix(gluonDataDir), gluonID+".db"))
+		}
+
+		// The store and the database of each gluon user are removed while the bridge isn't running.
+		require.NoError(t, bridge.DeleteGluonUsers(gluonCacheDir, gluonDataDir, gluonIDs))
+
+		for _, gluonID := range gluonIDs {
+			require.NoDirExists(t, filepath.Join(bridge.ApplyGluonCachePathSuffix(gluonCacheDir), gluonID))
+			require.NoFileExists(t, filepath.Join(bridge.ApplyGluonConfigPathSuffix(gluonDataDir), gluonID+".db"))
+		}
+	})
+}
+
 func TestBridge_FailLoginRecover(t *testing.T) {
 	for i := uint64(1); i < 10; i++ {
 		t.Run(fmt.Sprintf("read %v%% of the data", 100*i/10), func(t *testing.T) {
@@ -154,3 +154,11 @@ func uninstallCert(certPEM []byte) error {
 
 	return nil
 }
+
+func installCertToStores([]byte, TrustStores) ([]string, error) {
+	return nil, nil // Only Linux has NSS databases and trust anchors directories.
+}
+
+func uninstallCertFromStores([]byte, TrustStores) ([]string, error) {
+	return nil, nil // Only Linux has NSS databases and trust anchors directories.
+}
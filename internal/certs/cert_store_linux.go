@@ -13,14 +13,207 @@
 // GNU General Public License for more details.
 //
 // You should have received a copy of the GNU General Public License
-// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
 
 package certs
 
+import (
+	"bytes"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/execabs"
+)
+
+// trustStoreCertName is the name of the certificate in the NSS databases, and its file name in the anchors directory.
+const trustStoreCertName = "Proton Mail Bridge"
+
+const anchorFileName = "proton-mail-bridge.crt"
+
+var (
+	errNoNSSTools   = errors.New("certutil not found, the NSS tools must be installed (e.g. libnss3-tools or nss-tools)")
+	errNoAnchorsDir = errors.New("no known trust anchors directory found")
+)
+
+// anchorsDir is a directory of trust anchors, and the command which updates the system's trust stores from it.
+type anchorsDir struct {
+	path   string
+	update []string
+}
+
+// anchorsDirs are the trust anchors directories of the main distributions; the first existing one is used.
+var anchorsDirs = []anchorsDir{ //nolint:gochecknoglobals
+	{path: "/etc/pki/ca-trust/source/anchors", update: []string{"update-ca-trust", "extract"}},       // Fedora, RHEL
+	{path: "/usr/local/share/ca-certificates", update: []string{"update-ca-certificates"}},           // Debian, Ubuntu
+	{path: "/etc/ca-certificates/trust-source/anchors", update: []string{"trust", "extract-compat"}}, // Arch
+	{path: "/etc/pki/trust/anchors", update: []string{"update-ca-certificates"}},                     // openSUSE
+}
+
+// nssDBPatterns match the user's NSS databases, relative to their home directory.
+var nssDBPatterns = []string{ //nolint:gochecknoglobals
+	".pki/nssdb",
+	".thunderbird/*",
+	".mozilla/firefox/*",
+	"snap/thunderbird/common/.thunderbird/*",
+	"snap/firefox/common/.mozilla/firefox/*",
+	".var/app/org.mozilla.Thunderbird/.thunderbird/*",
+	".var/app/org.mozilla.firefox/.mozilla/firefox/*",
+}
+
 func installCert([]byte) error {
-	return nil // Linux doesn't have a root cert store.
+	return nil // Linux doesn't have a root cert store; see installCertToStores.
 }
 
 func uninstallCert([]byte) error {
-	return nil // Linux doesn't have a root cert store.
+	return nil // Linux doesn't have a root cert store; see uninstallCertFromStores.
+}
+
+func installCertToStores(certPEM []byte, stores TrustStores) ([]string, error) {
+	if block, _ := pem.Decode(certPEM); block == nil {
+		return nil, errors.New("invalid PEM certificate")
+	}
+
+	return updateStores(stores, func(db string) (bool, error) {
+		// Remove any previous certificate first, e.g. one which was regenerated since.
+		if _, err := uninstallNSSCert(db); err != nil {
+			return false, err
+		}
+
+		return true, runCertutil(certPEM, "-A", "-a", "-d", "sql:"+db, "-n", trustStoreCertName, "-t", "C,,")
+	}, func(dir anchorsDir) (bool, error) {
+		if err := os.WriteFile(filepath.Join(dir.path, anchorFileName), certPEM, 0o644); err != nil { //nolint:gosec
+			return false, err
+		}
+
+		return true, runUpdate(dir)
+	})
+}
+
+func uninstallCertFromStores(_ []byte, stores TrustStores) ([]string, error) {
+	return updateStores(stores, uninstallNSSCert, func(dir anchorsDir) (bool, error) {
+		if err := os.Remove(filepath.Join(dir.path, anchorFileName)); errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+
+		return true, runUpdate(dir)
+	})
+}
+
+// updateStores updates the given trust stores with the given functions, which return whether they changed the store.
+// It returns the stores which were changed; if some can't be updated, the others still are.
+func updateStores(
+	stores TrustStores,
+	updateNSS func(db string) (bool, error),
+	updateAnchors func(dir anchorsDir) (bool, error),
+) ([]string, error) {
+	var (
+		updated []string
+		errs    []error
+	)
+
+	if stores.NSS {
+		dbs, err := findNSSDBs()
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		for _, db := range dbs {
+			if ok, err := updateNSS(db); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update NSS database %v: %w", db, err))
+			} else if ok {
+				updated = append(updated, db)
+			}
+		}
+	}
+
+	if stores.System {
+		if dir, ok := findAnchorsDir(); !ok {
+			errs = append(errs, errNoAnchorsDir)
+		} else if ok, err := updateAnchors(dir); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update trust anchors in %v: %w", dir.path, err))
+		} else if ok {
+			updated = append(updated, filepath.Join(dir.path, anchorFileName))
+		}
+	}
+
+	return updated, errors.Join(errs...)
+}
+
+// uninstallNSSCert removes the certificate from the given NSS database, returning whether it was there.
+func uninstallNSSCert(db string) (bool, error) {
+	// Listing a certificate which doesn't exist fails, and so does deleting it.
+	if err := runCertutil(nil, "-L", "-d", "sql:"+db, "-n", trustStoreCertName); err != nil {
+		return false, nil //nolint:nilerr
+	}
+
+	return true, runCertutil(nil, "-D", "-d", "sql:"+db, "-n", trustStoreCertName)
+}
+
+// findNSSDBs returns the user's NSS databases, in the SQL format (cert9.db) used by all recent applications.
+// It fails if there are some but the NSS tools aren't installed.
+func findNSSDBs() ([]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	var dbs []string
+
+	for _, pattern := range nssDBPatterns {
+		matches, err := filepath.Glob(filepath.Join(home, pattern))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range matches {
+			if _, err := os.Stat(filepath.Join(match, "cert9.db")); err == nil {
+				dbs = append(dbs, match)
+			}
+		}
+	}
+
+	if len(dbs) > 0 {
+		if _, err := execabs.LookPath("certutil"); err != nil {
+			return nil, errNoNSSTools
+		}
+	}
+
+	return dbs, nil
+}
+
+func findAnchorsDir() (anchorsDir, bool) {
+	for _, dir := range anchorsDirs {
+		if info, err := os.Stat(dir.path); err == nil && info.IsDir() {
+			return dir, true
+		}
+	}
+
+	return anchorsDir{}, false
+}
+
+func runCertutil(stdin []byte, args ...string) error {
+	cmd := execabs.Command("certutil", args...)
+
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("certutil failed: %w: %s", err, bytes.TrimSpace(out))
+	}
+
+	return nil
+}
+
+func runUpdate(dir anchorsDir) error {
+	if out, err := execabs.Command(dir.update[0], dir.update[1:]...).CombinedOutput(); err != nil { //nolint:gosec
+		return fmt.Errorf("%v failed: %w: %s", dir.update[0], err, bytes.TrimSpace(out))
+	}
+
+	return nil
 }
@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCertutil stores the certificate added to an NSS database in a file next to it.
+const fakeCertutil = `#!/bin/sh
+op=$1; shift
+while [ $# -gt 0 ]; do
+	case $1 in -d) db=${2#sql:}; shift ;; esac
+	shift
+done
+case $op in
+	-L) test -f "$db/bridge.pem" ;;
+	-A) cat > "$db/bridge.pem" ;;
+	-D) rm "$db/bridge.pem" ;;
+esac
+`
+
+func TestTrustStoresLinux(t *testing.T) {
+	template, err := NewTLSTemplate()
+	require.NoError(t, err)
+
+	certPEM, _, err := GenerateCert(template)
+	require.NoError(t, err)
+
+	// Use a fake home directory with an NSS database for Evolution and one for a Thunderbird profile.
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	pkiDB := filepath.Join(home, ".pki", "nssdb")
+	thunderbirdDB := filepath.Join(home, ".thunderbird", "abcd.default")
+
+	for _, db := range []string{pkiDB, thunderbirdDB, filepath.Join(home, ".thunderbird", "Crash Reports")} {
+		require.NoError(t, os.MkdirAll(db, 0o700))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(pkiDB, "cert9.db"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(thunderbirdDB, "cert9.db"), nil, 0o600))
+
+	// Without the NSS tools, the NSS databases can't be updated.
+	t.Setenv("PATH", t.TempDir())
+
+	_, err = installCertToStores(certPEM, TrustStores{NSS: true})
+	require.ErrorIs(t, err, errNoNSSTools)
+
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "certutil"), []byte(fakeCertutil), 0o700)) //nolint:gosec
+	t.Setenv("PATH", bin+":/bin:/usr/bin")
+
+	// Use a fake anchors directory.
+	defer func(dirs []anchorsDir) { anchorsDirs = dirs }(anchorsDirs)
+
+	anchors := t.TempDir()
+	anchorsDirs = []anchorsDir{{path: filepath.Join(anchors, "missing")}, {path: anchors, update: []string{"true"}}}
+
+	// Installing the certificate updates all stores.
+	updated, err := installCertToStores(certPEM, TrustStores{NSS: true, System: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{pkiDB, thunderbirdDB, filepath.Join(anchors, anchorFileName)}, updated)
+
+	for _, path := range []string{filepath.Join(pkiDB, "bridge.pem"), filepath.Join(thunderbirdDB, "bridge.pem"), filepath.Join(anchors, anchorFileName)} {
+		installed, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, certPEM, installed)
+	}
+
+	// Only the selected stores are updated.
+	updated, err = uninstallCertFromStores(certPEM, TrustStores{System: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(anchors, anchorFileName)}, updated)
+	require.NoFileExists(t, filepath.Join(anchors, anchorFileName))
+
+	// Stores which don't have the certificate aren't reported.
+	updated, err = uninstallCertFromStores(certPEM, TrustStores{NSS: true, System: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{pkiDB, thunderbirdDB}, updated)
+	require.NoFileExists(t, filepath.Join(pkiDB, "bridge.pem"))
+
+	// Stores which can't be updated are reported as errors, without preventing the others from being updated.
+	anchorsDirs = []anchorsDir{{path: anchors, update: []string{"false"}}}
+
+	updated, err = installCertToStores(certPEM, TrustStores{NSS: true, System: true})
+	require.Error(t, err)
+	require.Equal(t, []string{pkiDB, thunderbirdDB}, updated)
+
+	// Invalid certificates are rejected.
+	_, err = installCertToStores([]byte{0}, TrustStores{NSS: true, System: true})
+	require.Error(t, err)
+}
@@ -24,3 +24,11 @@ func installCert([]byte) error {
 func uninstallCert([]byte) error {
 	return nil // NOTE(GODT-986): Uninstall certs from root cert store?
 }
+
+func installCertToStores([]byte, TrustStores) ([]string, error) {
+	return nil, nil // Only Linux has NSS databases and trust anchors directories.
+}
+
+func uninstallCertFromStores([]byte, TrustStores) ([]string, error) {
+	return nil, nil // Only Linux has NSS databases and trust anchors directories.
+}
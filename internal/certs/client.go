@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// clientCertValidity is how long the client certificates issued by the bridge are valid.
+const clientCertValidity = 2 * 365 * 24 * time.Hour
+
+// clientCAValidity is how long the CA issuing the client certificates is valid.
+const clientCAValidity = 10 * 365 * 24 * time.Hour
+
+// ErrNotCA is returned when issuing a client certificate with a certificate which can't sign other certificates.
+var ErrNotCA = errors.New("the certificate can't be used to issue other certificates")
+
+// NewClientCATemplate creates a new template for the CA certificate issuing the client certificates,
+// with a random serial number. Unlike the TLS certificate, it isn't served, so it can be kept much longer.
+func NewClientCATemplate() (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization:       []string{"Proton AG"},
+			OrganizationalUnit: []string{"Proton Mail Bridge"},
+			CommonName:         "Proton Mail Bridge Client CA",
+		},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(clientCAValidity),
+	}, nil
+}
+
+// NewClientCert generates a TLS client certificate with the given common name, issued by the given CA certificate.
+// It returns the certificate along with its private key.
+func NewClientCert(caCertPEM, caKeyPEM []byte, commonName string) (*x509.Certificate, crypto.PrivateKey, error) {
+	ca, err := tls.X509KeyPair(caCertPEM, caKeyPEM)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load keypair")
+	}
+
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse certificate")
+	}
+
+	if !caCert.IsCA || caCert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return nil, nil, ErrNotCA
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate serial number")
+	}
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate private key")
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization:       []string{"Proton AG"},
+			OrganizationalUnit: []string{"Proton Mail Bridge"},
+			CommonName:         commonName,
+		},
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(clientCertValidity),
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, caCert, &priv.PublicKey, ca.PrivateKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create certificate")
+	}
+
+	cert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse certificate")
+	}
+
+	return cert, priv, nil
+}
+
+// VerifyClientCert returns whether the given client certificate was issued by the given CA certificate.
+func VerifyClientCert(caCertPEM []byte, cert *x509.Certificate) bool {
+	roots := x509.NewCertPool()
+
+	if !roots.AppendCertsFromPEM(caCertPEM) {
+		return false
+	}
+
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	return err == nil
+}
+
+// GetFingerprint returns the hex-encoded SHA-256 hash of the given certificate.
+func GetFingerprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestNewClientCert(t *testing.T) {
+	tlsTemplate, err := NewTLSTemplate()
+	require.NoError(t, err)
+
+	caCertPEM, caKeyPEM, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+
+	// Issue a client certificate.
+	cert, _, err := NewClientCert(caCertPEM, caKeyPEM, "laptop")
+	require.NoError(t, err)
+	require.Equal(t, "laptop", cert.Subject.CommonName)
+	require.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+	require.Len(t, GetFingerprint(cert), 2*sha256.Size)
+
+	// It was issued by the CA certificate but not by another one.
+	require.True(t, VerifyClientCert(caCertPEM, cert))
+
+	otherCertPEM, _, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+	require.False(t, VerifyClientCert(otherCertPEM, cert))
+}
+
+func TestNewClientCert_NotCA(t *testing.T) {
+	tlsTemplate, err := NewTLSTemplate()
+	require.NoError(t, err)
+
+	tlsTemplate.IsCA = false
+	tlsTemplate.KeyUsage &^= x509.KeyUsageCertSign
+
+	certPEM, keyPEM, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+
+	_, _, err = NewClientCert(certPEM, keyPEM, "laptop")
+	require.ErrorIs(t, err, ErrNotCA)
+}
+
+func TestEncodePKCS12(t *testing.T) {
+	tlsTemplate, err := NewTLSTemplate()
+	require.NoError(t, err)
+
+	caCertPEM, caKeyPEM, err := GenerateCert(tlsTemplate)
+	require.NoError(t, err)
+
+	caBlock, _ := pem.Decode(caCertPEM)
+	require.NotNil(t, caBlock)
+
+	caCert, err := x509.ParseCertificate(caBlock.Bytes)
+	require.NoError(t, err)
+
+	cert, key, err := NewClientCert(caCertPEM, caKeyPEM, "laptop")
+	require.NoError(t, err)
+
+	// A password is required.
+	_, err = EncodePKCS12(key, []*x509.Certificate{cert, caCert}, "")
+	require.ErrorIs(t, err, ErrPKCS12PasswordRequired)
+
+	der, err := EncodePKCS12(key, []*x509.Certificate{cert, caCert}, "password")
+	require.NoError(t, err)
+
+	// The file can't be decoded without the password.
+	_, _, _, err = pkcs12.DecodeChain(der, "wrong")
+	require.ErrorIs(t, err, pkcs12.ErrIncorrectPassword)
+
+	// With it, it holds the key and the certificate chain.
+	decodedKey, decodedCert, decodedCACerts, err := pkcs12.DecodeChain(der, "password")
+	require.NoError(t, err)
+	require.Equal(t, key, decodedKey)
+	require.Equal(t, cert.Raw, decodedCert.Raw)
+	require.Len(t, decodedCACerts, 1)
+	require.Equal(t, caCert.Raw, decodedCACerts[0].Raw)
+}
@@ -19,6 +19,16 @@ package certs
 
 type Installer struct{}
 
+// TrustStores selects the trust stores to install the certificate into, besides the one used by InstallCert.
+// They are only found on Linux, where InstallCert does nothing.
+type TrustStores struct {
+	// NSS selects the user's NSS databases, used e.g. by Thunderbird, Evolution, Firefox and Chromium.
+	NSS bool
+
+	// System selects the system's trust anchors (p11-kit or update-ca-certificates layout), which requires root.
+	System bool
+}
+
 func NewInstaller() *Installer {
 	return &Installer{}
 }
@@ -30,3 +40,14 @@ func (installer *Installer) InstallCert(certPEM []byte) error {
 func (installer *Installer) UninstallCert(certPEM []byte) error {
 	return uninstallCert(certPEM)
 }
+
+// InstallCertToStores installs the certificate into the given trust stores and returns those which were updated.
+// If some can't be updated, the others still are.
+func (installer *Installer) InstallCertToStores(certPEM []byte, stores TrustStores) ([]string, error) {
+	return installCertToStores(certPEM, stores)
+}
+
+// UninstallCertFromStores uninstalls the certificate from the given trust stores and returns those which were updated.
+func (installer *Installer) UninstallCertFromStores(certPEM []byte, stores TrustStores) ([]string, error) {
+	return uninstallCertFromStores(certPEM, stores)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"crypto"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// ErrPKCS12PasswordRequired is returned when encoding a PKCS#12 file without a password.
+var ErrPKCS12PasswordRequired = errors.New("a password is required to protect the private key")
+
+// EncodePKCS12 encodes the given private key and certificate chain, leaf first, as a PKCS#12 file
+// which mail clients and operating systems can import.
+// The private key and certificates are encrypted with PBES2 (PBKDF2 with HMAC-SHA256, AES-256-CBC) and the file is
+// authenticated with HMAC-SHA256, both with keys derived from the given password.
+func EncodePKCS12(key crypto.PrivateKey, chain []*x509.Certificate, password string) ([]byte, error) {
+	if password == "" {
+		return nil, ErrPKCS12PasswordRequired
+	}
+
+	if len(chain) == 0 {
+		return nil, errors.New("the certificate chain is empty")
+	}
+
+	return pkcs12.Modern2023.Encode(key, chain[0], chain[1:], password)
+}
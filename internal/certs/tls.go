@@ -19,6 +19,10 @@ package certs
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -28,6 +32,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -36,8 +41,83 @@ import (
 // ErrTLSCertExpiresSoon is returned when the TLS certificate is about to expire.
 var ErrTLSCertExpiresSoon = fmt.Errorf("TLS certificate will expire soon")
 
-// NewTLSTemplate creates a new TLS template certificate with a random serial number.
+// ExpiryWarningPeriod is how long before its expiry a TLS certificate is considered to expire soon.
+const ExpiryWarningPeriod = 31 * 24 * time.Hour
+
+// tlsCertValidity is how long the generated TLS certificates are valid,
+// the longest Apple platforms accept for TLS server certificates.
+const tlsCertValidity = 825 * 24 * time.Hour
+
+// ErrInvalidHost is returned when a TLS certificate is requested for something which is neither a hostname nor an IP address.
+var ErrInvalidHost = errors.New("invalid hostname or IP address")
+
+// DefaultHost is the host of the TLS certificate when none is given.
+const DefaultHost = "127.0.0.1"
+
+// KeyType is the type of the private key of a TLS certificate.
+type KeyType int
+
+const (
+	KeyTypeRSA KeyType = iota
+	KeyTypeECDSA
+	KeyTypeEd25519
+)
+
+func (keyType KeyType) String() string {
+	switch keyType {
+	case KeyTypeRSA:
+		return "rsa"
+
+	case KeyTypeECDSA:
+		return "ecdsa"
+
+	case KeyTypeEd25519:
+		return "ed25519"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ParseKeyType parses a key type as returned by KeyType.String.
+func ParseKeyType(value string) (KeyType, error) {
+	for _, keyType := range []KeyType{KeyTypeRSA, KeyTypeECDSA, KeyTypeEd25519} {
+		if keyType.String() == strings.ToLower(value) {
+			return keyType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown key type %q (rsa, ecdsa or ed25519)", value)
+}
+
+// NewTLSTemplate creates a new TLS template certificate with a random serial number, for the default host.
 func NewTLSTemplate() (*x509.Certificate, error) {
+	return NewTLSTemplateForHosts(nil)
+}
+
+// NewTLSTemplateForHosts creates a new TLS template certificate with a random serial number,
+// valid for the given hostnames and IP addresses, or for the default host if none is given.
+// The first one is used as the common name.
+func NewTLSTemplateForHosts(hosts []string) (*x509.Certificate, error) {
+	if len(hosts) == 0 {
+		hosts = []string{DefaultHost}
+	}
+
+	var (
+		dnsNames    []string
+		ipAddresses []net.IP
+	)
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			ipAddresses = append(ipAddresses, ip)
+		} else if isValidHostname(host) {
+			dnsNames = append(dnsNames, host)
+		} else {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidHost, host)
+		}
+	}
+
 	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate serial number")
@@ -49,26 +129,39 @@ func NewTLSTemplate() (*x509.Certificate, error) {
 			Country:            []string{"CH"},
 			Organization:       []string{"Proton AG"},
 			OrganizationalUnit: []string{"Proton Mail"},
-			CommonName:         "127.0.0.1",
+			CommonName:         hosts[0],
 		},
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 		BasicConstraintsValid: true,
 		IsCA:                  true,
-		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              dnsNames,
+		IPAddresses:           ipAddresses,
 		NotBefore:             time.Now(),
-		NotAfter:              time.Now().Add(20 * 365 * 24 * time.Hour),
+		NotAfter:              time.Now().Add(tlsCertValidity),
 	}, nil
 }
 
-// GenerateCert generates a new TLS certificate and returns it as PEM.
+// GenerateCert generates a new self-signed TLS certificate with an RSA key and returns it as PEM.
 var GenerateCert = func(template *x509.Certificate) ([]byte, []byte, error) { //nolint:gochecknoglobals
-	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	return GenerateCertWithKeyType(template, KeyTypeRSA)
+}
+
+// GenerateCertWithKeyType generates a new self-signed TLS certificate with a key of the given type and returns it as PEM.
+func GenerateCertWithKeyType(template *x509.Certificate, keyType KeyType) ([]byte, []byte, error) {
+	priv, keyBlock, err := generateKey(keyType)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to generate private key")
 	}
 
-	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	// Only RSA keys can be used for key encipherment.
+	if keyType != KeyTypeRSA {
+		withoutKeyEncipherment := *template
+		withoutKeyEncipherment.KeyUsage &^= x509.KeyUsageKeyEncipherment
+		template = &withoutKeyEncipherment
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, priv.Public(), priv)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to create certificate")
 	}
@@ -81,13 +174,88 @@ var GenerateCert = func(template *x509.Certificate) ([]byte, []byte, error) { //
 
 	keyPEM := new(bytes.Buffer)
 
-	if err := pem.Encode(keyPEM, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)}); err != nil {
+	if err := pem.Encode(keyPEM, keyBlock); err != nil {
 		return nil, nil, err
 	}
 
 	return certPEM.Bytes(), keyPEM.Bytes(), nil
 }
 
+// generateKey generates a private key of the given type, and returns it along with its PEM block.
+func generateKey(keyType KeyType) (crypto.Signer, *pem.Block, error) {
+	switch keyType {
+	case KeyTypeRSA:
+		priv, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return priv, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)}, nil
+
+	case KeyTypeECDSA:
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		der, err := x509.MarshalECPrivateKey(priv)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return priv, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}, nil
+
+	case KeyTypeEd25519:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		der, err := x509.MarshalPKCS8PrivateKey(priv)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return priv, &pem.Block{Type: "PRIVATE KEY", Bytes: der}, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown key type %v", keyType)
+	}
+}
+
+// isValidHostname returns whether the given string can be used as a DNS name in a certificate,
+// possibly with a leading wildcard label.
+func isValidHostname(host string) bool {
+	host = strings.TrimPrefix(host, "*.")
+
+	if host == "" || len(host) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// CheckExpiry returns ErrTLSCertExpiresSoon if the certificate expires within ExpiryWarningPeriod.
+func CheckExpiry(cert *x509.Certificate) error {
+	if time.Now().Add(ExpiryWarningPeriod).After(cert.NotAfter) {
+		return ErrTLSCertExpiresSoon
+	}
+
+	return nil
+}
+
 // GetConfig tries to load TLS config or generate new one which is then returned.
 func GetConfig(certPEM, keyPEM []byte) (*tls.Config, error) {
 	c, err := tls.X509KeyPair(certPEM, keyPEM)
@@ -100,8 +268,8 @@ func GetConfig(certPEM, keyPEM []byte) (*tls.Config, error) {
 		return nil, errors.Wrap(err, "failed to parse certificate")
 	}
 
-	if time.Now().Add(31 * 24 * time.Hour).After(c.Leaf.NotAfter) {
-		return nil, ErrTLSCertExpiresSoon
+	if err := CheckExpiry(c.Leaf); err != nil {
+		return nil, err
 	}
 
 	caCertPool := x509.NewCertPool()
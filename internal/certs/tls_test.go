@@ -77,3 +77,49 @@ func TestNewConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cert)
 }
+
+func TestNewTLSTemplateForHosts(t *testing.T) {
+	// The default host is used if none is given.
+	tlsTemplate, err := NewTLSTemplateForHosts(nil)
+	require.NoError(t, err)
+	require.Equal(t, DefaultHost, tlsTemplate.Subject.CommonName)
+	require.Empty(t, tlsTemplate.DNSNames)
+	require.Len(t, tlsTemplate.IPAddresses, 1)
+
+	// Hostnames and IP addresses are told apart.
+	tlsTemplate, err = NewTLSTemplateForHosts([]string{"bridge.local", "::1", "*.example.com", "192.168.1.10"})
+	require.NoError(t, err)
+	require.Equal(t, "bridge.local", tlsTemplate.Subject.CommonName)
+	require.Equal(t, []string{"bridge.local", "*.example.com"}, tlsTemplate.DNSNames)
+	require.Len(t, tlsTemplate.IPAddresses, 2)
+
+	// Anything else is rejected.
+	for _, host := range []string{"", "bridge local", "-bridge", "bridge..local", "bridge/local"} {
+		_, err := NewTLSTemplateForHosts([]string{host})
+		require.ErrorIs(t, err, ErrInvalidHost, host)
+	}
+}
+
+func TestGenerateCertWithKeyType(t *testing.T) {
+	tlsTemplate, err := NewTLSTemplateForHosts([]string{"bridge.local", "::1"})
+	require.NoError(t, err)
+
+	for _, keyType := range []KeyType{KeyTypeRSA, KeyTypeECDSA, KeyTypeEd25519} {
+		certPEM, keyPEM, err := GenerateCertWithKeyType(tlsTemplate, keyType)
+		require.NoError(t, err, keyType)
+
+		config, err := GetConfig(certPEM, keyPEM)
+		require.NoError(t, err, keyType)
+
+		// The certificate is valid for the given hosts only.
+		leaf := config.Certificates[0].Leaf
+		require.NoError(t, leaf.VerifyHostname("bridge.local"), keyType)
+		require.NoError(t, leaf.VerifyHostname("::1"), keyType)
+		require.Error(t, leaf.VerifyHostname("127.0.0.1"), keyType)
+
+		// The key type can be parsed back.
+		parsed, err := ParseKeyType(keyType.String())
+		require.NoError(t, err)
+		require.Equal(t, keyType, parsed)
+	}
+}
@@ -17,6 +17,11 @@
 
 package events
 
+import (
+	"fmt"
+	"time"
+)
+
 type TLSIssue struct {
 	eventBase
 }
@@ -40,3 +45,14 @@ type ConnStatusDown struct {
 func (event ConnStatusDown) String() string {
 	return "ConnStatusDown"
 }
+
+// TLSCertExpiresSoon is published when the TLS certificate served to IMAP and SMTP clients is about to expire.
+type TLSCertExpiresSoon struct {
+	eventBase
+
+	NotAfter time.Time
+}
+
+func (event TLSCertExpiresSoon) String() string {
+	return fmt.Sprintf("TLSCertExpiresSoon: NotAfter: %v", event.NotAfter)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// OutboxMessageQueued is emitted when a message could not be sent right away and has been queued in the user's outbox.
+type OutboxMessageQueued struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+	Error     error
+}
+
+func (event OutboxMessageQueued) String() string {
+	return fmt.Sprintf("OutboxMessageQueued: UserID: %s, MessageID: %s, Error: %s", event.UserID, event.MessageID, event.Error)
+}
+
+// OutboxMessageSent is emitted when a queued message has been sent.
+type OutboxMessageSent struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+}
+
+func (event OutboxMessageSent) String() string {
+	return fmt.Sprintf("OutboxMessageSent: UserID: %s, MessageID: %s", event.UserID, event.MessageID)
+}
+
+// OutboxMessageFailed is emitted when a queued message could not be sent and has been removed from the user's outbox.
+type OutboxMessageFailed struct {
+	eventBase
+
+	UserID    string
+	MessageID string
+	Error     error
+}
+
+func (event OutboxMessageFailed) String() string {
+	return fmt.Sprintf("OutboxMessageFailed: UserID: %s, MessageID: %s, Error: %s", event.UserID, event.MessageID, event.Error)
+}
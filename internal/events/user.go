@@ -19,6 +19,7 @@ package events
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 )
@@ -192,6 +193,24 @@ func (event IMAPLoginFailed) String() string {
 	return fmt.Sprintf("IMAPLoginFailed: Username: %s", event.Username)
 }
 
+// AuthLockout is emitted when a username is locked out from a source after too many failed IMAP or SMTP authentication attempts.
+type AuthLockout struct {
+	eventBase
+
+	// Source is the address from which the attempts were made.
+	Source string
+
+	// Username is the username with which the attempts were made.
+	Username string
+
+	Failures int
+	Until    time.Time
+}
+
+func (event AuthLockout) String() string {
+	return fmt.Sprintf("AuthLockout: Source: %s, Username: %s, Failures: %d, Until: %s", event.Source, event.Username, event.Failures, event.Until)
+}
+
 type UncategorizedEventError struct {
 	eventBase
 
@@ -65,7 +65,7 @@ func TestFocus_Version(t *testing.T) {
 }
 
 type TestLocationsProvider struct {
-	config, data, cache string
+	config, data, cache, runtime string
 }
 
 func newTestLocationsProvider(dir string) *TestLocationsProvider {
@@ -84,10 +84,16 @@ func newTestLocationsProvider(dir string) *TestLocationsProvider {
 		panic(err)
 	}
 
+	runtime, err := os.MkdirTemp(dir, "runtime")
+	if err != nil {
+		panic(err)
+	}
+
 	return &TestLocationsProvider{
-		config: config,
-		data:   data,
-		cache:  cache,
+		config:  config,
+		data:    data,
+		cache:   cache,
+		runtime: runtime,
 	}
 }
 
@@ -102,3 +108,7 @@ func (provider *TestLocationsProvider) UserData() string {
 func (provider *TestLocationsProvider) UserCache() string {
 	return provider.cache
 }
+
+func (provider *TestLocationsProvider) UserRuntime() string {
+	return provider.runtime
+}
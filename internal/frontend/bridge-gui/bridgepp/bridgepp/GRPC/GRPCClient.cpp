@@ -126,7 +126,7 @@ void GRPCClient::connectToServer(QString const &sessionID, QString const &config
         serverToken_ = config.token.toStdString();
         QString address;
         grpc::ChannelArguments chanArgs;
-        if (useFileSocketForGRPC()) {
+        if (useFileSocketForGRPC() || !config.fileSocketPath.isEmpty()) { // bridge may be configured to use a file socket on any platform.
             address = QString("unix://" + config.fileSocketPath);
             chanArgs.SetSslTargetNameOverride("127.0.0.1"); // for file socket, we skip name verification to avoid a confusion localhost/127.0.0.1
         } else {
@@ -0,0 +1,187 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/abiosoft/ishell"
+	"golang.org/x/exp/slices"
+)
+
+func (f *frontendCLI) listAppPasswords(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	appPasswords, err := f.bridge.GetAppPasswords(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot get app passwords: ", err)
+		return
+	}
+
+	if len(appPasswords) == 0 {
+		f.Printf("%s has no app passwords.\n", bold(user.Username))
+		return
+	}
+
+	spacing := "%-36s %-20s %-10s %-5s %-30s %-20s %s\n"
+	f.Printf(bold(spacing), "id", "name", "imap", "smtp", "addresses", "created at", "last used at")
+	for _, appPass := range appPasswords {
+		addresses, lastUsedAt := "all", "never"
+
+		if len(appPass.Scope.Addresses) > 0 {
+			addresses = strings.Join(appPass.Scope.Addresses, ", ")
+		}
+
+		if !appPass.LastUsedAt.IsZero() {
+			lastUsedAt = appPass.LastUsedAt.Format("2006-01-02 15:04:05")
+		}
+
+		f.Printf(spacing,
+			appPass.ID,
+			appPass.Name,
+			appPass.Scope.IMAP,
+			appPass.Scope.SMTP,
+			addresses,
+			appPass.CreatedAt.Format("2006-01-02 15:04:05"),
+			lastUsedAt,
+		)
+	}
+	f.Println()
+}
+
+func (f *frontendCLI) createAppPassword(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	name := f.readStringInAttempts("Name of the device using the app password", c.ReadLine, isNotEmpty)
+	if name == "" {
+		return
+	}
+
+	var scope vault.AppPasswordScope
+
+	switch f.readStringInAttempts("IMAP access (read-write, read-only or none)", c.ReadLine, isOneOf("read-write", "read-only", "none")) {
+	case "read-write":
+		scope.IMAP = vault.IMAPReadWrite
+
+	case "read-only":
+		scope.IMAP = vault.IMAPReadOnly
+
+	case "none":
+		scope.IMAP = vault.IMAPDenied
+
+	default:
+		return
+	}
+
+	switch f.readStringInAttempts("SMTP access (send or none)", c.ReadLine, isOneOf("send", "none")) {
+	case "send":
+		scope.SMTP = vault.SMTPSend
+
+	case "none":
+		scope.SMTP = vault.SMTPDenied
+
+	default:
+		return
+	}
+
+	f.Print("Addresses the app password may be used with, separated by commas (leave empty for all): ")
+
+	for _, address := range strings.Split(c.ReadLine(), ",") {
+		if address = strings.TrimSpace(address); address == "" {
+			continue
+		}
+
+		if !slices.ContainsFunc(user.Addresses, func(other string) bool { return strings.EqualFold(other, address) }) {
+			f.Printf("Wrong input '%s'. It is not an address of %s.\n", bold(address), bold(user.Username))
+			return
+		}
+
+		scope.Addresses = append(scope.Addresses, address)
+	}
+
+	_, password, err := f.bridge.CreateAppPassword(user.UserID, name, scope)
+	if errors.Is(err, vault.ErrAppPasswordExists) {
+		f.Printf("%s already has an app password named %s.\n", bold(user.Username), bold(name))
+		return
+	} else if err != nil {
+		f.printAndLogError("Cannot create app password: ", err)
+		return
+	}
+
+	f.Println("The app password is shown only once; use it instead of the bridge password on this device:")
+	f.Println(bold(string(password)))
+}
+
+func (f *frontendCLI) revokeAppPassword(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	if len(c.Args) != 1 {
+		f.Println("Please provide the ID of the app password to revoke, as printed by app-passwords list.")
+		return
+	}
+
+	appPassID := c.Args[0]
+
+	for _, userID := range f.bridge.GetUserIDs() {
+		appPasswords, err := f.bridge.GetAppPasswords(userID)
+		if err != nil {
+			f.printAndLogError("Cannot get app passwords: ", err)
+			return
+		}
+
+		for _, appPass := range appPasswords {
+			if appPass.ID != appPassID {
+				continue
+			}
+
+			if !f.yesNoQuestion("Are you sure you want to " + bold("revoke the app password "+appPass.Name)) {
+				return
+			}
+
+			if err := f.bridge.RevokeAppPassword(userID, appPassID); err != nil {
+				f.printAndLogError("Cannot revoke app password: ", err)
+				return
+			}
+
+			f.Println("App password revoked.")
+
+			return
+		}
+	}
+
+	f.Printf("Wrong input '%s'. No app password has this ID.\n", bold(appPassID))
+}
+
+// isOneOf returns a function checking that a value is one of the given ones.
+func isOneOf(values ...string) func(string) bool {
+	return func(val string) bool {
+		return slices.Contains(values, val)
+	}
+}
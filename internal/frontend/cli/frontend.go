@@ -21,6 +21,7 @@ package cli
 import (
 	"errors"
 	"os"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
@@ -279,6 +280,72 @@ func New(
 		Completer: fe.completeUsernames,
 	})
 
+	// Outbox commands.
+	outboxCmd := &ishell.Cmd{
+		Name: "outbox",
+		Help: "manage messages waiting to be sent because Proton could not be reached",
+	}
+	outboxCmd.AddCmd(&ishell.Cmd{
+		Name:      "list",
+		Help:      "print the messages waiting to be sent for account. Use index or account name as parameter. (aliases: l, ls)",
+		Func:      fe.noAccountWrapper(fe.listOutbox),
+		Aliases:   []string{"l", "ls"},
+		Completer: fe.completeUsernames,
+	})
+	outboxCmd.AddCmd(&ishell.Cmd{
+		Name:    "cancel",
+		Help:    "cancel sending a message. Use the message ID as parameter. (aliases: rm, remove)",
+		Func:    fe.noAccountWrapper(fe.cancelOutboxMessage),
+		Aliases: []string{"rm", "remove"},
+	})
+	fe.AddCmd(outboxCmd)
+
+	// App password commands.
+	appPasswordsCmd := &ishell.Cmd{
+		Name: "app-passwords",
+		Help: "manage the passwords which can be used instead of the bridge password, typically one per device",
+	}
+	appPasswordsCmd.AddCmd(&ishell.Cmd{
+		Name:      "list",
+		Help:      "print the app passwords of account. Use index or account name as parameter. (aliases: l, ls)",
+		Func:      fe.noAccountWrapper(fe.listAppPasswords),
+		Aliases:   []string{"l", "ls"},
+		Completer: fe.completeUsernames,
+	})
+	appPasswordsCmd.AddCmd(&ishell.Cmd{
+		Name:      "create",
+		Help:      "create an app password for account. Use index or account name as parameter. (aliases: c, add)",
+		Func:      fe.noAccountWrapper(fe.createAppPassword),
+		Aliases:   []string{"c", "add"},
+		Completer: fe.completeUsernames,
+	})
+	appPasswordsCmd.AddCmd(&ishell.Cmd{
+		Name:    "revoke",
+		Help:    "revoke an app password. Use the app password ID as parameter. (aliases: rm, remove)",
+		Func:    fe.noAccountWrapper(fe.revokeAppPassword),
+		Aliases: []string{"rm", "remove"},
+	})
+	fe.AddCmd(appPasswordsCmd)
+
+	// Lockout commands.
+	lockoutsCmd := &ishell.Cmd{
+		Name: "lockouts",
+		Help: "manage the sources and usernames locked out after too many failed IMAP or SMTP login attempts",
+	}
+	lockoutsCmd.AddCmd(&ishell.Cmd{
+		Name:    "list",
+		Help:    "print the sources and usernames which are locked out. (aliases: l, ls)",
+		Func:    fe.listAuthLockouts,
+		Aliases: []string{"l", "ls"},
+	})
+	lockoutsCmd.AddCmd(&ishell.Cmd{
+		Name:    "clear",
+		Help:    "lift all lockouts and forget the failed login attempts. (aliases: c)",
+		Func:    fe.clearAuthLockouts,
+		Aliases: []string{"c"},
+	})
+	fe.AddCmd(lockoutsCmd)
+
 	badEventCmd := &ishell.Cmd{
 		Name: "bad-event",
 		Help: "manage actions when bad event error occurs",
@@ -391,6 +458,9 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 		case events.IMAPLoginFailed:
 			f.Printf("An IMAP login attempt failed for user %v\n", event.Username)
 
+		case events.AuthLockout:
+			f.Printf("Too many failed login attempts for user %v from %v, locked out until %v\n", event.Username, event.Source, event.Until.Format("15:04:05"))
+
 		case events.UserAddressEnabled:
 			user, err := f.bridge.GetUserInfo(event.UserID)
 			if err != nil {
@@ -445,6 +515,15 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 				event.Remaining.Seconds(),
 			)
 
+		case events.OutboxMessageQueued:
+			f.Printf("A message could not be sent and has been queued; it will be sent once Proton can be reached (%v).\n", event.Error)
+
+		case events.OutboxMessageSent:
+			f.Printf("A queued message was sent.\n")
+
+		case events.OutboxMessageFailed:
+			f.Printf("A queued message could not be sent and was dropped (%v).\n", event.Error)
+
 		case events.UpdateAvailable:
 			if !event.Compatible {
 				f.Printf("A new version (%v) is available but it cannot be installed automatically.\n", event.Version.Version)
@@ -464,6 +543,9 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 		case events.TLSIssue:
 			f.notifyCertIssue()
 
+		case events.TLSCertExpiresSoon:
+			f.Printf("Warning: the TLS certificate used by the IMAP and SMTP servers expires on %v; renew it or import a new one.\n", event.NotAfter.Format(time.RFC1123))
+
 		case events.Raise:
 			f.Printf("Hello!")
 		}
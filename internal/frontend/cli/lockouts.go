@@ -15,27 +15,41 @@
 // You should have received a copy of the GNU General Public License
 // along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
 
-//go:build debug
-
-package vault
+package cli
 
 import (
-	"encoding/json"
+	"github.com/abiosoft/ishell"
 )
 
-func (vault *Vault) ImportJSON(dec []byte) {
-	vault.modSafe(func(data *Data) {
-		if err := json.Unmarshal(dec, data); err != nil {
-			panic(err)
-		}
-	})
+func (f *frontendCLI) listAuthLockouts(_ *ishell.Context) {
+	lockouts := f.bridge.GetAuthLockouts()
+	if len(lockouts) == 0 {
+		f.Println("Nothing is locked out.")
+		return
+	}
+
+	spacing := "%-40s %-40s %-8s %s\n"
+	f.Printf(bold(spacing), "source", "username", "failures", "until")
+	for _, lockout := range lockouts {
+		f.Printf(spacing,
+			lockout.Source,
+			lockout.Username,
+			lockout.Failures,
+			lockout.Until.Format("2006-01-02 15:04:05"),
+		)
+	}
+	f.Println()
 }
 
-func (vault *Vault) ExportJSON() []byte {
-	enc, err := json.MarshalIndent(vault.getSafe(), "", "  ")
-	if err != nil {
-		panic(err)
+func (f *frontendCLI) clearAuthLockouts(_ *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	if !f.yesNoQuestion("Are you sure you want to " + bold("lift all lockouts")) {
+		return
 	}
 
-	return enc
+	f.bridge.ClearAuthLockouts()
+
+	f.Println("Lockouts lifted.")
 }
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/abiosoft/ishell"
+)
+
+func (f *frontendCLI) listOutbox(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	messages, err := f.bridge.GetOutbox(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot get outbox: ", err)
+		return
+	}
+
+	if len(messages) == 0 {
+		f.Printf("The outbox of %s is empty.\n", bold(user.Username))
+		return
+	}
+
+	spacing := "%-36s %-20s %-30s %-8s %s\n"
+	f.Printf(bold(spacing), "id", "queued at", "recipients", "attempts", "subject")
+	for _, message := range messages {
+		f.Printf(spacing,
+			message.ID,
+			message.QueuedAt.Format("2006-01-02 15:04:05"),
+			strings.Join(message.To, ", "),
+			message.Attempts,
+			message.Subject,
+		)
+	}
+	f.Println()
+}
+
+func (f *frontendCLI) cancelOutboxMessage(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	if len(c.Args) != 1 {
+		f.Println("Please provide the ID of the message to cancel, as printed by outbox list.")
+		return
+	}
+
+	messageID := c.Args[0]
+
+	for _, userID := range f.bridge.GetUserIDs() {
+		messages, err := f.bridge.GetOutbox(userID)
+		if err != nil {
+			f.printAndLogError("Cannot get outbox: ", err)
+			return
+		}
+
+		for _, message := range messages {
+			if message.ID != messageID {
+				continue
+			}
+
+			if !f.yesNoQuestion("Are you sure you want to " + bold("cancel sending the message to "+strings.Join(message.To, ", "))) {
+				return
+			}
+
+			if err := f.bridge.CancelOutboxMessage(userID, messageID); err != nil {
+				f.printAndLogError("Cannot cancel message: ", err)
+				return
+			}
+
+			f.Println("Message canceled.")
+
+			return
+		}
+	}
+
+	f.Printf("Wrong input '%s'. No queued message has this ID.\n", bold(messageID))
+}
@@ -276,7 +276,7 @@ func (f *frontendCLI) importTLSCerts(c *ishell.Context) {
 		return
 	}
 
-	f.Println("TLS certificate imported. Restart Bridge to use it.")
+	f.Println("TLS certificate imported. It is used right away, and reloaded whenever the files change.")
 }
 
 func (f *frontendCLI) isPortFree(port string) bool {
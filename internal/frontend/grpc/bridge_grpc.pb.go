@@ -52,6 +52,8 @@ type BridgeClient interface {
 	CurrentEmailClient(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	ReportBug(ctx context.Context, in *ReportBugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ExportTLSCertificates(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTLSCertOptions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TLSCertOptions, error)
+	SetTLSCertOptions(ctx context.Context, in *TLSCertOptions, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetMainExecutable(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// login
@@ -78,6 +80,7 @@ type BridgeClient interface {
 	AvailableKeychains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AvailableKeychainsResponse, error)
 	SetCurrentKeychain(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CurrentKeychain(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	RekeyVault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// User & user list
 	GetUserList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserListResponse, error)
 	GetUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*User, error)
@@ -86,6 +89,21 @@ type BridgeClient interface {
 	LogoutUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ConfigureUserAppleMail(ctx context.Context, in *ConfigureAppleMailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserOutbox(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*OutboxMessageListResponse, error)
+	CancelOutboxMessage(ctx context.Context, in *CancelOutboxMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lockouts after too many failed IMAP or SMTP login attempts
+	GetAuthLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthLockoutListResponse, error)
+	ClearAuthLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TLS client certificates
+	GetClientCertMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClientCertModeValue, error)
+	SetClientCertMode(ctx context.Context, in *ClientCertModeValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetClientCerts(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*ClientCertListResponse, error)
+	EnrollClientCert(ctx context.Context, in *EnrollClientCertRequest, opts ...grpc.CallOption) (*EnrollClientCertResponse, error)
+	RemoveClientCert(ctx context.Context, in *RemoveClientCertRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// App passwords
+	GetAppPasswords(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AppPasswordListResponse, error)
+	CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error)
+	RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Telemetry
 	ReportBugClicked(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AutoconfigClicked(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -346,6 +364,24 @@ func (c *bridgeClient) ExportTLSCertificates(ctx context.Context, in *wrapperspb
 	return out, nil
 }
 
+func (c *bridgeClient) GetTLSCertOptions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TLSCertOptions, error) {
+	out := new(TLSCertOptions)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetTLSCertOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetTLSCertOptions(ctx context.Context, in *TLSCertOptions, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/SetTLSCertOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/ForceLauncher", in, out, opts...)
@@ -535,6 +571,15 @@ func (c *bridgeClient) CurrentKeychain(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
+func (c *bridgeClient) RekeyVault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/RekeyVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) GetUserList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserListResponse, error) {
 	out := new(UserListResponse)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetUserList", in, out, opts...)
@@ -598,6 +643,114 @@ func (c *bridgeClient) ConfigureUserAppleMail(ctx context.Context, in *Configure
 	return out, nil
 }
 
+func (c *bridgeClient) GetUserOutbox(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*OutboxMessageListResponse, error) {
+	out := new(OutboxMessageListResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetUserOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) CancelOutboxMessage(ctx context.Context, in *CancelOutboxMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/CancelOutboxMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) GetAuthLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthLockoutListResponse, error) {
+	out := new(AuthLockoutListResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetAuthLockouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) ClearAuthLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/ClearAuthLockouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) GetClientCertMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClientCertModeValue, error) {
+	out := new(ClientCertModeValue)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetClientCertMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetClientCertMode(ctx context.Context, in *ClientCertModeValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/SetClientCertMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) GetClientCerts(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*ClientCertListResponse, error) {
+	out := new(ClientCertListResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetClientCerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) EnrollClientCert(ctx context.Context, in *EnrollClientCertRequest, opts ...grpc.CallOption) (*EnrollClientCertResponse, error) {
+	out := new(EnrollClientCertResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/EnrollClientCert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) RemoveClientCert(ctx context.Context, in *RemoveClientCertRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/RemoveClientCert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) GetAppPasswords(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AppPasswordListResponse, error) {
+	out := new(AppPasswordListResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/GetAppPasswords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error) {
+	out := new(CreateAppPasswordResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/CreateAppPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.Bridge/RevokeAppPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) ReportBugClicked(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/grpc.Bridge/ReportBugClicked", in, out, opts...)
@@ -698,6 +851,8 @@ type BridgeServer interface {
 	CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error)
 	ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	GetTLSCertOptions(context.Context, *emptypb.Empty) (*TLSCertOptions, error)
+	SetTLSCertOptions(context.Context, *TLSCertOptions) (*emptypb.Empty, error)
 	ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	// login
@@ -724,6 +879,7 @@ type BridgeServer interface {
 	AvailableKeychains(context.Context, *emptypb.Empty) (*AvailableKeychainsResponse, error)
 	SetCurrentKeychain(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	CurrentKeychain(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	RekeyVault(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// User & user list
 	GetUserList(context.Context, *emptypb.Empty) (*UserListResponse, error)
 	GetUser(context.Context, *wrapperspb.StringValue) (*User, error)
@@ -732,6 +888,21 @@ type BridgeServer interface {
 	LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	RemoveUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	ConfigureUserAppleMail(context.Context, *ConfigureAppleMailRequest) (*emptypb.Empty, error)
+	GetUserOutbox(context.Context, *wrapperspb.StringValue) (*OutboxMessageListResponse, error)
+	CancelOutboxMessage(context.Context, *CancelOutboxMessageRequest) (*emptypb.Empty, error)
+	// Lockouts after too many failed IMAP or SMTP login attempts
+	GetAuthLockouts(context.Context, *emptypb.Empty) (*AuthLockoutListResponse, error)
+	ClearAuthLockouts(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// TLS client certificates
+	GetClientCertMode(context.Context, *emptypb.Empty) (*ClientCertModeValue, error)
+	SetClientCertMode(context.Context, *ClientCertModeValue) (*emptypb.Empty, error)
+	GetClientCerts(context.Context, *wrapperspb.StringValue) (*ClientCertListResponse, error)
+	EnrollClientCert(context.Context, *EnrollClientCertRequest) (*EnrollClientCertResponse, error)
+	RemoveClientCert(context.Context, *RemoveClientCertRequest) (*emptypb.Empty, error)
+	// App passwords
+	GetAppPasswords(context.Context, *wrapperspb.StringValue) (*AppPasswordListResponse, error)
+	CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error)
+	RevokeAppPassword(context.Context, *RevokeAppPasswordRequest) (*emptypb.Empty, error)
 	// Telemetry
 	ReportBugClicked(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	AutoconfigClicked(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
@@ -827,6 +998,12 @@ func (UnimplementedBridgeServer) ReportBug(context.Context, *ReportBugRequest) (
 func (UnimplementedBridgeServer) ExportTLSCertificates(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportTLSCertificates not implemented")
 }
+func (UnimplementedBridgeServer) GetTLSCertOptions(context.Context, *emptypb.Empty) (*TLSCertOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTLSCertOptions not implemented")
+}
+func (UnimplementedBridgeServer) SetTLSCertOptions(context.Context, *TLSCertOptions) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTLSCertOptions not implemented")
+}
 func (UnimplementedBridgeServer) ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceLauncher not implemented")
 }
@@ -890,6 +1067,9 @@ func (UnimplementedBridgeServer) SetCurrentKeychain(context.Context, *wrapperspb
 func (UnimplementedBridgeServer) CurrentKeychain(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentKeychain not implemented")
 }
+func (UnimplementedBridgeServer) RekeyVault(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RekeyVault not implemented")
+}
 func (UnimplementedBridgeServer) GetUserList(context.Context, *emptypb.Empty) (*UserListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserList not implemented")
 }
@@ -911,6 +1091,42 @@ func (UnimplementedBridgeServer) RemoveUser(context.Context, *wrapperspb.StringV
 func (UnimplementedBridgeServer) ConfigureUserAppleMail(context.Context, *ConfigureAppleMailRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfigureUserAppleMail not implemented")
 }
+func (UnimplementedBridgeServer) GetUserOutbox(context.Context, *wrapperspb.StringValue) (*OutboxMessageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOutbox not implemented")
+}
+func (UnimplementedBridgeServer) CancelOutboxMessage(context.Context, *CancelOutboxMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOutboxMessage not implemented")
+}
+func (UnimplementedBridgeServer) GetAuthLockouts(context.Context, *emptypb.Empty) (*AuthLockoutListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthLockouts not implemented")
+}
+func (UnimplementedBridgeServer) ClearAuthLockouts(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAuthLockouts not implemented")
+}
+func (UnimplementedBridgeServer) GetClientCertMode(context.Context, *emptypb.Empty) (*ClientCertModeValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientCertMode not implemented")
+}
+func (UnimplementedBridgeServer) SetClientCertMode(context.Context, *ClientCertModeValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClientCertMode not implemented")
+}
+func (UnimplementedBridgeServer) GetClientCerts(context.Context, *wrapperspb.StringValue) (*ClientCertListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientCerts not implemented")
+}
+func (UnimplementedBridgeServer) EnrollClientCert(context.Context, *EnrollClientCertRequest) (*EnrollClientCertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollClientCert not implemented")
+}
+func (UnimplementedBridgeServer) RemoveClientCert(context.Context, *RemoveClientCertRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClientCert not implemented")
+}
+func (UnimplementedBridgeServer) GetAppPasswords(context.Context, *wrapperspb.StringValue) (*AppPasswordListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppPasswords not implemented")
+}
+func (UnimplementedBridgeServer) CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppPassword not implemented")
+}
+func (UnimplementedBridgeServer) RevokeAppPassword(context.Context, *RevokeAppPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAppPassword not implemented")
+}
 func (UnimplementedBridgeServer) ReportBugClicked(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportBugClicked not implemented")
 }
@@ -1425,6 +1641,42 @@ func _Bridge_ExportTLSCertificates_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_GetTLSCertOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetTLSCertOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetTLSCertOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetTLSCertOptions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetTLSCertOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSCertOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetTLSCertOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/SetTLSCertOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetTLSCertOptions(ctx, req.(*TLSCertOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_ForceLauncher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
@@ -1803,6 +2055,24 @@ func _Bridge_CurrentKeychain_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_RekeyVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RekeyVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/RekeyVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RekeyVault(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_GetUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1929,6 +2199,222 @@ func _Bridge_ConfigureUserAppleMail_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_GetUserOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetUserOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetUserOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetUserOutbox(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CancelOutboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOutboxMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CancelOutboxMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/CancelOutboxMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CancelOutboxMessage(ctx, req.(*CancelOutboxMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GetAuthLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetAuthLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetAuthLockouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetAuthLockouts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ClearAuthLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ClearAuthLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/ClearAuthLockouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ClearAuthLockouts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GetClientCertMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetClientCertMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetClientCertMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetClientCertMode(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetClientCertMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientCertModeValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetClientCertMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/SetClientCertMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetClientCertMode(ctx, req.(*ClientCertModeValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GetClientCerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetClientCerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetClientCerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetClientCerts(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_EnrollClientCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollClientCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).EnrollClientCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/EnrollClientCert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).EnrollClientCert(ctx, req.(*EnrollClientCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_RemoveClientCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveClientCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RemoveClientCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/RemoveClientCert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RemoveClientCert(ctx, req.(*RemoveClientCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GetAppPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetAppPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/GetAppPasswords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetAppPasswords(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CreateAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CreateAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/CreateAppPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CreateAppPassword(ctx, req.(*CreateAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_RevokeAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RevokeAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bridge/RevokeAppPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RevokeAppPassword(ctx, req.(*RevokeAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_ReportBugClicked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2137,6 +2623,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportTLSCertificates",
 			Handler:    _Bridge_ExportTLSCertificates_Handler,
 		},
+		{
+			MethodName: "GetTLSCertOptions",
+			Handler:    _Bridge_GetTLSCertOptions_Handler,
+		},
+		{
+			MethodName: "SetTLSCertOptions",
+			Handler:    _Bridge_SetTLSCertOptions_Handler,
+		},
 		{
 			MethodName: "ForceLauncher",
 			Handler:    _Bridge_ForceLauncher_Handler,
@@ -2221,6 +2715,10 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentKeychain",
 			Handler:    _Bridge_CurrentKeychain_Handler,
 		},
+		{
+			MethodName: "RekeyVault",
+			Handler:    _Bridge_RekeyVault_Handler,
+		},
 		{
 			MethodName: "GetUserList",
 			Handler:    _Bridge_GetUserList_Handler,
@@ -2249,6 +2747,54 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfigureUserAppleMail",
 			Handler:    _Bridge_ConfigureUserAppleMail_Handler,
 		},
+		{
+			MethodName: "GetUserOutbox",
+			Handler:    _Bridge_GetUserOutbox_Handler,
+		},
+		{
+			MethodName: "CancelOutboxMessage",
+			Handler:    _Bridge_CancelOutboxMessage_Handler,
+		},
+		{
+			MethodName: "GetAuthLockouts",
+			Handler:    _Bridge_GetAuthLockouts_Handler,
+		},
+		{
+			MethodName: "ClearAuthLockouts",
+			Handler:    _Bridge_ClearAuthLockouts_Handler,
+		},
+		{
+			MethodName: "GetClientCertMode",
+			Handler:    _Bridge_GetClientCertMode_Handler,
+		},
+		{
+			MethodName: "SetClientCertMode",
+			Handler:    _Bridge_SetClientCertMode_Handler,
+		},
+		{
+			MethodName: "GetClientCerts",
+			Handler:    _Bridge_GetClientCerts_Handler,
+		},
+		{
+			MethodName: "EnrollClientCert",
+			Handler:    _Bridge_EnrollClientCert_Handler,
+		},
+		{
+			MethodName: "RemoveClientCert",
+			Handler:    _Bridge_RemoveClientCert_Handler,
+		},
+		{
+			MethodName: "GetAppPasswords",
+			Handler:    _Bridge_GetAppPasswords_Handler,
+		},
+		{
+			MethodName: "CreateAppPassword",
+			Handler:    _Bridge_CreateAppPassword_Handler,
+		},
+		{
+			MethodName: "RevokeAppPassword",
+			Handler:    _Bridge_RevokeAppPassword_Handler,
+		},
 		{
 			MethodName: "ReportBugClicked",
 			Handler:    _Bridge_ReportBugClicked_Handler,
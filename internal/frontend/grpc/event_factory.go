@@ -17,6 +17,8 @@
 
 package grpc
 
+import "time"
+
 func NewInternetStatusEvent(connected bool) *StreamEvent {
 	return appEvent(&AppEvent{Event: &AppEvent_InternetStatus{InternetStatus: &InternetStatusEvent{Connected: connected}}})
 }
@@ -202,6 +204,38 @@ func NewSyncProgressEvent(userID string, progress float64, elapsedMs, remainingM
 	}}})
 }
 
+func NewOutboxMessageQueuedEvent(userID, messageID, errorMessage string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_OutboxMessageQueuedEvent{OutboxMessageQueuedEvent: &OutboxMessageQueuedEvent{
+		UserID:       userID,
+		MessageID:    messageID,
+		ErrorMessage: errorMessage,
+	}}})
+}
+
+func NewOutboxMessageSentEvent(userID, messageID string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_OutboxMessageSentEvent{OutboxMessageSentEvent: &OutboxMessageSentEvent{
+		UserID:    userID,
+		MessageID: messageID,
+	}}})
+}
+
+func NewOutboxMessageFailedEvent(userID, messageID, errorMessage string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_OutboxMessageFailedEvent{OutboxMessageFailedEvent: &OutboxMessageFailedEvent{
+		UserID:       userID,
+		MessageID:    messageID,
+		ErrorMessage: errorMessage,
+	}}})
+}
+
+func NewAuthLockoutEvent(source, username string, failures int, until time.Time) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_AuthLockoutEvent{AuthLockoutEvent: &AuthLockoutEvent{Lockout: &AuthLockout{
+		Source:   source,
+		Username: username,
+		Failures: int32(failures),
+		Until:    until.Unix(),
+	}}}})
+}
+
 func NewGenericErrorEvent(errorCode ErrorCode) *StreamEvent {
 	return genericErrorEvent(&GenericErrorEvent{Code: errorCode})
 }
@@ -55,6 +55,7 @@ import (
 
 const (
 	serverConfigFileName   = "grpcServerConfig.json"
+	grpcSocketName         = "grpc.sock"
 	serverTokenMetadataKey = "server-token"
 )
 
@@ -99,7 +100,7 @@ type Service struct { // nolint:structcheck
 func NewService(
 	panicHandler async.PanicHandler,
 	restarter Restarter,
-	locations service.Locator,
+	locations Locator,
 	bridge *bridge.Bridge,
 	eventCh <-chan events.Event,
 	quitCh <-chan struct{},
@@ -117,7 +118,22 @@ func NewService(
 	}
 
 	var listener net.Listener
-	if useFileSocket() {
+	if bridge.GetUnixSockets() {
+		var err error
+		if config.FileSocketPath, err = computeRuntimeSocketPath(locations); err != nil {
+			logrus.WithError(err).Panic("Could not create gRPC file socket")
+		}
+
+		listener, err = net.Listen("unix", config.FileSocketPath)
+		if err != nil {
+			logrus.WithError(err).Panic("Could not create gRPC file socket listener")
+		}
+
+		// The runtime directory is only accessible to the user already; restrict the socket itself too.
+		if err := os.Chmod(config.FileSocketPath, 0o600); err != nil {
+			logrus.WithError(err).Panic("Could not restrict gRPC file socket permissions")
+		}
+	} else if useFileSocket() {
 		var err error
 		if config.FileSocketPath, err = computeFileSocketPath(); err != nil {
 			logrus.WithError(err).WithError(err).Panic("Could not create gRPC file socket")
@@ -219,7 +235,7 @@ func (s *Service) Loop() error {
 		s.watchEvents()
 	}()
 
-	s.log.WithField("useFileSocket", useFileSocket()).Info("Starting gRPC server")
+	s.log.WithField("useFileSocket", s.listener.Addr().Network() == "unix").Info("Starting gRPC server")
 
 	doneCh := make(chan struct{})
 	defer close(doneCh)
@@ -341,6 +357,18 @@ func (s *Service) watchEvents() {
 		case events.SyncProgress:
 			_ = s.SendEvent(NewSyncProgressEvent(event.UserID, event.Progress, event.Elapsed.Milliseconds(), event.Remaining.Milliseconds()))
 
+		case events.OutboxMessageQueued:
+			_ = s.SendEvent(NewOutboxMessageQueuedEvent(event.UserID, event.MessageID, event.Error.Error()))
+
+		case events.OutboxMessageSent:
+			_ = s.SendEvent(NewOutboxMessageSentEvent(event.UserID, event.MessageID))
+
+		case events.OutboxMessageFailed:
+			_ = s.SendEvent(NewOutboxMessageFailedEvent(event.UserID, event.MessageID, event.Error.Error()))
+
+		case events.AuthLockout:
+			_ = s.SendEvent(NewAuthLockoutEvent(event.Source, event.Username, event.Failures, event.Until))
+
 		case events.UpdateLatest:
 			safe.RLock(func() {
 				s.latest = event.Version
@@ -608,6 +636,23 @@ func computeFileSocketPath() (string, error) {
 	return "", errors.New("unable to find a suitable file socket in user config folder")
 }
 
+// computeRuntimeSocketPath returns the path of the gRPC socket file in the user's runtime directory.
+// A socket file left behind by a previous run is removed.
+func computeRuntimeSocketPath(locations Locator) (string, error) {
+	runtimePath, err := locations.ProvideRuntimePath()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(runtimePath, grpcSocketName)
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	return path, nil
+}
+
 // useFileSocket return true iff file socket should be used for the gRPC service.
 func useFileSocket() bool {
 	//goland:noinspection GoBoolExpressions
@@ -29,12 +29,14 @@ import (
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/theme"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/service"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
 	"github.com/sirupsen/logrus"
@@ -382,6 +384,28 @@ func (s *Service) ExportTLSCertificates(_ context.Context, folderPath *wrappersp
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) GetTLSCertOptions(_ context.Context, _ *emptypb.Empty) (*TLSCertOptions, error) {
+	s.log.Debug("GetTLSCertOptions")
+
+	return &TLSCertOptions{
+		Hosts:   s.bridge.GetTLSCertHosts(),
+		KeyType: tlsKeyTypeToGrpc(s.bridge.GetTLSKeyType()),
+	}, nil
+}
+
+func (s *Service) SetTLSCertOptions(_ context.Context, options *TLSCertOptions) (*emptypb.Empty, error) {
+	s.log.WithField("hosts", options.Hosts).WithField("keyType", options.KeyType).Debug("SetTLSCertOptions")
+
+	if err := s.bridge.RegenerateTLSCert(options.Hosts, tlsKeyTypeFromGrpc(options.KeyType)); errors.Is(err, certs.ErrInvalidHost) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to regenerate TLS certificate")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) ForceLauncher(_ context.Context, launcher *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	s.log.WithField("launcher", launcher.Value).Debug("ForceLauncher")
 
@@ -738,7 +762,14 @@ func (s *Service) AvailableKeychains(_ context.Context, _ *emptypb.Empty) (*Avai
 func (s *Service) SetCurrentKeychain(ctx context.Context, keychain *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	s.log.WithField("keychain", keychain.Value).Debug("SetCurrentKeyChain") // we do not check validity.
 
-	defer func() { _, _ = s.Restart(ctx, &emptypb.Empty{}) }()
+	// Bridge restarts to load the vault key from the new keychain, unless it stayed in the current one.
+	restart := true
+
+	defer func() {
+		if restart {
+			_, _ = s.Restart(ctx, &emptypb.Empty{})
+		}
+	}()
 	defer func() { _ = s.SendEvent(NewKeychainChangeKeychainFinishedEvent()) }()
 
 	helper, err := s.bridge.GetKeychainApp()
@@ -753,6 +784,13 @@ func (s *Service) SetCurrentKeychain(ctx context.Context, keychain *wrapperspb.S
 
 	if err := s.bridge.SetKeychainApp(keychain.Value); err != nil {
 		s.log.WithError(err).Error("Failed to set keychain")
+
+		restart = false
+
+		if errors.Is(err, vault.ErrNoVaultKey) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to set keychain: %v", err)
+		}
+
 		return nil, status.Errorf(codes.Internal, "failed to set keychain: %v", err)
 	}
 
@@ -771,6 +809,20 @@ func (s *Service) CurrentKeychain(_ context.Context, _ *emptypb.Empty) (*wrapper
 	return wrapperspb.String(helper), nil
 }
 
+// RekeyVault rotates the key of the vault and the keys of the users' gluon databases, re-encrypting them.
+func (s *Service) RekeyVault(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	s.log.Debug("RekeyVault")
+
+	if err := s.bridge.RekeyVault(ctx); errors.Is(err, bridge.ErrVaultInsecure) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to rekey vault")
+		return nil, status.Errorf(codes.Internal, "failed to rekey vault: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func base64Decode(in []byte) ([]byte, error) {
 	out := make([]byte, base64.StdEncoding.DecodedLen(len(in)))
 
@@ -19,9 +19,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -164,3 +169,179 @@ func (s *Service) ConfigureUserAppleMail(ctx context.Context, request *Configure
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Service) GetUserOutbox(_ context.Context, userID *wrapperspb.StringValue) (*OutboxMessageListResponse, error) {
+	s.log.WithField("UserID", userID.Value).Debug("GetUserOutbox")
+
+	messages, err := s.bridge.GetOutbox(userID.Value)
+	if errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", userID.Value)
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to get outbox")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &OutboxMessageListResponse{Messages: xslices.Map(messages, grpcOutboxMessageFromInfo)}, nil
+}
+
+func (s *Service) CancelOutboxMessage(_ context.Context, request *CancelOutboxMessageRequest) (*emptypb.Empty, error) {
+	s.log.WithField("UserID", request.UserID).WithField("MessageID", request.MessageID).Debug("CancelOutboxMessage")
+
+	if err := s.bridge.CancelOutboxMessage(request.UserID, request.MessageID); errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+	} else if errors.Is(err, vault.ErrNoSuchOutboxMessage) {
+		return nil, status.Errorf(codes.NotFound, "message not found %v", request.MessageID)
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to cancel outbox message")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetAuthLockouts(_ context.Context, _ *emptypb.Empty) (*AuthLockoutListResponse, error) {
+	s.log.Debug("GetAuthLockouts")
+
+	return &AuthLockoutListResponse{Lockouts: xslices.Map(s.bridge.GetAuthLockouts(), grpcAuthLockoutFromInfo)}, nil
+}
+
+func (s *Service) ClearAuthLockouts(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	s.log.Debug("ClearAuthLockouts")
+
+	s.bridge.ClearAuthLockouts()
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetClientCertMode(_ context.Context, _ *emptypb.Empty) (*ClientCertModeValue, error) {
+	s.log.Debug("GetClientCertMode")
+
+	return &ClientCertModeValue{Mode: clientCertModeToGrpc(s.bridge.GetClientCertMode())}, nil
+}
+
+func (s *Service) SetClientCertMode(_ context.Context, mode *ClientCertModeValue) (*emptypb.Empty, error) {
+	s.log.WithField("Mode", mode.Mode).Debug("SetClientCertMode")
+
+	if err := s.bridge.SetClientCertMode(clientCertModeFromGrpc(mode.Mode)); err != nil {
+		s.log.WithError(err).Error("Failed to set client certificate mode")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetClientCerts(_ context.Context, userID *wrapperspb.StringValue) (*ClientCertListResponse, error) {
+	s.log.WithField("UserID", userID.Value).Debug("GetClientCerts")
+
+	clientCerts, err := s.bridge.GetClientCerts(userID.Value)
+	if errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", userID.Value)
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to get client certificates")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &ClientCertListResponse{Certs: xslices.Map(clientCerts, grpcClientCertFromInfo)}, nil
+}
+
+func (s *Service) EnrollClientCert(_ context.Context, request *EnrollClientCertRequest) (*EnrollClientCertResponse, error) {
+	s.log.WithField("UserID", request.UserID).WithField("Name", request.Name).Debug("EnrollClientCert")
+
+	clientCert, pkcs12, password, err := s.bridge.EnrollClientCert(request.UserID, request.Name, request.SkipPassword)
+	if errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+	} else if errors.Is(err, vault.ErrClientCertExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to enroll client certificate")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &EnrollClientCertResponse{
+		Cert:     grpcClientCertFromInfo(clientCert),
+		Pkcs12:   pkcs12,
+		Password: string(password),
+	}, nil
+}
+
+func (s *Service) RemoveClientCert(_ context.Context, request *RemoveClientCertRequest) (*emptypb.Empty, error) {
+	s.log.WithField("UserID", request.UserID).WithField("CertID", request.CertID).Debug("RemoveClientCert")
+
+	if err := s.bridge.RemoveClientCert(request.UserID, request.CertID); errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+	} else if errors.Is(err, vault.ErrNoSuchClientCert) {
+		return nil, status.Errorf(codes.NotFound, "client certificate not found %v", request.CertID)
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to remove client certificate")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetAppPasswords(_ context.Context, userID *wrapperspb.StringValue) (*AppPasswordListResponse, error) {
+	s.log.WithField("UserID", userID.Value).Debug("GetAppPasswords")
+
+	appPasswords, err := s.bridge.GetAppPasswords(userID.Value)
+	if errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", userID.Value)
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to get app passwords")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &AppPasswordListResponse{AppPasswords: xslices.Map(appPasswords, grpcAppPasswordFromInfo)}, nil
+}
+
+func (s *Service) CreateAppPassword(_ context.Context, request *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error) {
+	s.log.WithField("UserID", request.UserID).WithField("Name", request.Name).Debug("CreateAppPassword")
+
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "the app password must have a name")
+	}
+
+	scope := appPasswordScopeFromGrpc(request.Scope)
+
+	if len(scope.Addresses) > 0 {
+		user, err := s.bridge.GetUserInfo(request.UserID)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+		}
+
+		for _, address := range scope.Addresses {
+			if !slices.ContainsFunc(user.Addresses, func(other string) bool { return strings.EqualFold(other, address) }) {
+				return nil, status.Errorf(codes.InvalidArgument, "%v is not an address of the user", address)
+			}
+		}
+	}
+
+	appPass, password, err := s.bridge.CreateAppPassword(request.UserID, request.Name, scope)
+	if errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+	} else if errors.Is(err, vault.ErrAppPasswordExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to create app password")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &CreateAppPasswordResponse{
+		AppPassword: grpcAppPasswordFromInfo(appPass),
+		Password:    string(password),
+	}, nil
+}
+
+func (s *Service) RevokeAppPassword(_ context.Context, request *RevokeAppPasswordRequest) (*emptypb.Empty, error) {
+	s.log.WithField("UserID", request.UserID).WithField("AppPasswordID", request.AppPasswordID).Debug("RevokeAppPassword")
+
+	if err := s.bridge.RevokeAppPassword(request.UserID, request.AppPasswordID); errors.Is(err, bridge.ErrNoSuchUser) {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", request.UserID)
+	} else if errors.Is(err, vault.ErrNoSuchAppPassword) {
+		return nil, status.Errorf(codes.NotFound, "app password not found %v", request.AppPasswordID)
+	} else if err != nil {
+		s.log.WithError(err).Error("Failed to revoke app password")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
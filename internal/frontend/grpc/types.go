@@ -17,6 +17,14 @@
 
 package grpc
 
+import "github.com/ProtonMail/proton-bridge/v3/internal/service"
+
+// Locator provides the paths used by the gRPC service.
+type Locator interface {
+	service.Locator
+	ProvideRuntimePath() (string, error)
+}
+
 type Restarter interface {
 	Set(restart, crash bool)
 	AddFlags(flags ...string)
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/sirupsen/logrus"
 )
@@ -73,6 +74,153 @@ func grpcUserFromInfo(user bridge.UserInfo) *User {
 	}
 }
 
+func grpcOutboxMessageFromInfo(message bridge.OutboxMessageInfo) *OutboxMessage {
+	return &OutboxMessage{
+		Id:        message.ID,
+		From:      message.From,
+		To:        message.To,
+		Subject:   message.Subject,
+		Size:      int64(message.Size),
+		QueuedAt:  message.QueuedAt.Unix(),
+		Attempts:  int32(message.Attempts),
+		LastError: message.LastError,
+	}
+}
+
+func grpcAuthLockoutFromInfo(lockout bridge.AuthLockout) *AuthLockout {
+	return &AuthLockout{
+		Source:   lockout.Source,
+		Username: lockout.Username,
+		Failures: int32(lockout.Failures),
+		Until:    lockout.Until.Unix(),
+	}
+}
+
+func grpcClientCertFromInfo(clientCert bridge.ClientCertInfo) *ClientCert {
+	return &ClientCert{
+		Id:           clientCert.ID,
+		Name:         clientCert.Name,
+		Fingerprint:  clientCert.Fingerprint,
+		Subject:      clientCert.Subject,
+		SkipPassword: clientCert.SkipPassword,
+		CreatedAt:    clientCert.CreatedAt.Unix(),
+	}
+}
+
+func grpcAppPasswordFromInfo(appPass bridge.AppPasswordInfo) *AppPassword {
+	var lastUsedAt int64
+
+	if !appPass.LastUsedAt.IsZero() {
+		lastUsedAt = appPass.LastUsedAt.Unix()
+	}
+
+	return &AppPassword{
+		Id:   appPass.ID,
+		Name: appPass.Name,
+		Scope: &AppPasswordScope{
+			Imap:      appPasswordIMAPAccessToGrpc(appPass.Scope.IMAP),
+			Smtp:      appPasswordSMTPAccessToGrpc(appPass.Scope.SMTP),
+			Addresses: appPass.Scope.Addresses,
+		},
+		CreatedAt:  appPass.CreatedAt.Unix(),
+		LastUsedAt: lastUsedAt,
+	}
+}
+
+func appPasswordScopeFromGrpc(scope *AppPasswordScope) vault.AppPasswordScope {
+	if scope == nil {
+		return vault.AppPasswordScope{}
+	}
+
+	return vault.AppPasswordScope{
+		IMAP:      appPasswordIMAPAccessFromGrpc(scope.Imap),
+		SMTP:      appPasswordSMTPAccessFromGrpc(scope.Smtp),
+		Addresses: scope.Addresses,
+	}
+}
+
+func appPasswordIMAPAccessToGrpc(access vault.IMAPAccess) AppPasswordImapAccess {
+	switch access {
+	case vault.IMAPReadOnly:
+		return AppPasswordImapAccess_APP_PASSWORD_IMAP_READ_ONLY
+	case vault.IMAPDenied:
+		return AppPasswordImapAccess_APP_PASSWORD_IMAP_DENIED
+	default:
+		return AppPasswordImapAccess_APP_PASSWORD_IMAP_READ_WRITE
+	}
+}
+
+func appPasswordIMAPAccessFromGrpc(access AppPasswordImapAccess) vault.IMAPAccess {
+	switch access {
+	case AppPasswordImapAccess_APP_PASSWORD_IMAP_READ_ONLY:
+		return vault.IMAPReadOnly
+	case AppPasswordImapAccess_APP_PASSWORD_IMAP_DENIED:
+		return vault.IMAPDenied
+	default:
+		return vault.IMAPReadWrite
+	}
+}
+
+func appPasswordSMTPAccessToGrpc(access vault.SMTPAccess) AppPasswordSmtpAccess {
+	if access == vault.SMTPDenied {
+		return AppPasswordSmtpAccess_APP_PASSWORD_SMTP_DENIED
+	}
+
+	return AppPasswordSmtpAccess_APP_PASSWORD_SMTP_SEND
+}
+
+func appPasswordSMTPAccessFromGrpc(access AppPasswordSmtpAccess) vault.SMTPAccess {
+	if access == AppPasswordSmtpAccess_APP_PASSWORD_SMTP_DENIED {
+		return vault.SMTPDenied
+	}
+
+	return vault.SMTPSend
+}
+
+func tlsKeyTypeToGrpc(keyType certs.KeyType) TLSKeyType {
+	switch keyType {
+	case certs.KeyTypeECDSA:
+		return TLSKeyType_TLS_KEY_ECDSA
+	case certs.KeyTypeEd25519:
+		return TLSKeyType_TLS_KEY_ED25519
+	default:
+		return TLSKeyType_TLS_KEY_RSA
+	}
+}
+
+func tlsKeyTypeFromGrpc(keyType TLSKeyType) certs.KeyType {
+	switch keyType {
+	case TLSKeyType_TLS_KEY_ECDSA:
+		return certs.KeyTypeECDSA
+	case TLSKeyType_TLS_KEY_ED25519:
+		return certs.KeyTypeEd25519
+	default:
+		return certs.KeyTypeRSA
+	}
+}
+
+func clientCertModeToGrpc(mode vault.ClientCertMode) ClientCertMode {
+	switch mode {
+	case vault.ClientCertsOptional:
+		return ClientCertMode_CLIENT_CERTS_OPTIONAL
+	case vault.ClientCertsRequired:
+		return ClientCertMode_CLIENT_CERTS_REQUIRED
+	default:
+		return ClientCertMode_CLIENT_CERTS_OFF
+	}
+}
+
+func clientCertModeFromGrpc(mode ClientCertMode) vault.ClientCertMode {
+	switch mode {
+	case ClientCertMode_CLIENT_CERTS_OPTIONAL:
+		return vault.ClientCertsOptional
+	case ClientCertMode_CLIENT_CERTS_REQUIRED:
+		return vault.ClientCertsRequired
+	default:
+		return vault.ClientCertsOff
+	}
+}
+
 func userStateToGrpc(state bridge.UserState) UserState {
 	switch state {
 	case bridge.SignedOut:
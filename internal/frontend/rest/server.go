@@ -0,0 +1,199 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package rest provides a local HTTP/JSON management API for headless use of the Bridge.
+package rest
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/service"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	serverConfigFileName = "restServerConfig.json"
+	authorizationPrefix  = "Bearer "
+)
+
+// Bridge is the part of the bridge served by the REST API.
+type Bridge interface {
+	LoginAuth(ctx context.Context, username string, password []byte) (*proton.Client, proton.Auth, error)
+	LoginUser(ctx context.Context, client *proton.Client, auth proton.Auth, keyPass []byte) (string, error)
+
+	GetUserIDs() []string
+	GetUserInfo(userID string) (bridge.UserInfo, error)
+	HasUser(userID string) bool
+	LogoutUser(ctx context.Context, userID string) error
+	DeleteUser(ctx context.Context, userID string) error
+	SetAddressMode(ctx context.Context, userID string, mode vault.AddressMode) error
+
+	GetIMAPPort() int
+	SetIMAPPort(ctx context.Context, newPort int) error
+	GetIMAPSSL() bool
+	SetIMAPSSL(ctx context.Context, newSSL bool) error
+	GetSMTPPort() int
+	SetSMTPPort(ctx context.Context, newPort int) error
+	GetSMTPSSL() bool
+	SetSMTPSSL(ctx context.Context, newSSL bool) error
+
+	GetEvents(ofType ...events.Event) (<-chan events.Event, context.CancelFunc)
+}
+
+// Server is the REST API server.
+type Server struct {
+	httpServer *http.Server
+	listener   net.Listener
+	token      string
+
+	panicHandler async.PanicHandler
+	bridge       Bridge
+	quitCh       <-chan struct{}
+
+	// logins holds the unfinished logins, keyed by the token returned when they were started.
+	logins     map[string]*pendingLogin
+	loginsLock sync.Mutex
+
+	log *logrus.Entry
+}
+
+// NewServer returns a new REST API server listening on the given local port.
+// If the port is 0, a free port is chosen by the OS. The port and the token required to access the API
+// are written to a config file in the settings directory so that scripts can discover them.
+func NewServer(
+	panicHandler async.PanicHandler,
+	locations service.Locator,
+	bridge Bridge,
+	quitCh <-chan struct{},
+	port int,
+) (*Server, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port))
+	if err != nil {
+		return nil, fmt.Errorf("could not create REST API listener: %w", err)
+	}
+
+	address, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		return nil, fmt.Errorf("could not retrieve REST API listener address")
+	}
+
+	config := service.Config{
+		Port:  address.Port,
+		Token: uuid.NewString(),
+	}
+
+	path, err := service.SaveGRPCServerConfigFile(locations, &config, serverConfigFileName)
+	if err != nil {
+		return nil, fmt.Errorf("could not write REST API config file: %w", err)
+	}
+
+	logrus.WithField("path", path).Info("Successfully saved REST API config file")
+
+	s := &Server{
+		listener: listener,
+		token:    config.Token,
+
+		panicHandler: panicHandler,
+		bridge:       bridge,
+		quitCh:       quitCh,
+
+		logins: make(map[string]*pendingLogin),
+
+		log: logrus.WithField("pkg", "rest"),
+	}
+
+	s.httpServer = &http.Server{
+		Handler:           s.newHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.log.Info("REST API listening on ", s.listener.Addr())
+
+	return s, nil
+}
+
+// Loop serves the REST API until the app quits.
+func (s *Server) Loop() error {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		select {
+		case <-s.quitCh:
+			s.log.Info("Stopping REST API server")
+			defer s.log.Info("Stopped REST API server")
+
+			if err := s.httpServer.Shutdown(context.Background()); err != nil {
+				s.log.WithError(err).Error("Failed to shutdown REST API server")
+			}
+
+		case <-doneCh:
+			// ...
+		}
+	}()
+
+	if err := s.httpServer.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.log.WithError(err).Error("Failed to serve REST API")
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) newHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/v1/users", s.handleUsers)
+	mux.HandleFunc("/v1/users/", s.handleUser)
+	mux.HandleFunc("/v1/login", s.handleLogin)
+	mux.HandleFunc("/v1/login/", s.handleLoginStep)
+	mux.HandleFunc("/v1/settings/mail-server", s.handleMailServerSettings)
+	mux.HandleFunc("/v1/events", s.handleEvents)
+
+	return s.withTokenValidation(mux)
+}
+
+// withTokenValidation ensures that every request carries the token written in the REST API config file.
+func (s *Server) withTokenValidation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), authorizationPrefix)
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("invalid or missing token"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+)
+
+// eventKeepAliveInterval is the interval at which a comment is sent on idle event streams,
+// so that proxies and clients do not consider the connection dead.
+const eventKeepAliveInterval = 30 * time.Second
+
+// handleEvents handles GET /v1/events. Bridge events are streamed as server-sent events until the client disconnects.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errors.New("streaming is not supported"))
+		return
+	}
+
+	s.log.Debug("Starting event stream")
+	defer s.log.Debug("Event stream stopped")
+
+	eventCh, done := s.bridge.GetEvents()
+	defer done()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(eventKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-s.quitCh:
+			return
+
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+			flusher.Flush()
+
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+
+			if err := writeEvent(w, event); err != nil {
+				s.log.WithError(err).Warn("Failed to write event")
+				return
+			}
+
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event events.Event) error {
+	res := newEventResponse(event)
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", res.Type, b)
+
+	return err
+}
+
+// newEventResponse converts a bridge event to its JSON representation.
+// Every exported field of the event is included; errors are converted to their message.
+func newEventResponse(event events.Event) eventResponse {
+	val := reflect.Indirect(reflect.ValueOf(event))
+	typ := val.Type()
+
+	data := make(map[string]any)
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		value := val.Field(i).Interface()
+
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+
+		data[field.Name] = value
+	}
+
+	return eventResponse{
+		Type:        typ.Name(),
+		Description: event.String(),
+		Data:        data,
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/google/uuid"
+)
+
+// loginTimeout is the time after which an unfinished login is discarded.
+const loginTimeout = 10 * time.Minute
+
+var errNoSuchLogin = errors.New("no such login in progress, start with POST /v1/login")
+
+// pendingLogin is the state of an unfinished login, between the credentials, 2FA and mailbox password steps.
+type pendingLogin struct {
+	client    *proton.Client
+	auth      proton.Auth
+	password  []byte
+	startedAt time.Time
+}
+
+// clean wipes the password of the login.
+func (login *pendingLogin) clean() {
+	for i := range login.password {
+		login.password[i] = '\x00'
+	}
+
+	login.password = login.password[0:0]
+}
+
+// discard abandons the login.
+func (login *pendingLogin) discard() {
+	login.clean()
+	login.client.Close()
+}
+
+// handleLogin handles POST /v1/login, which starts a login.
+// If more steps are needed, the response holds a token identifying the login in the next requests.
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req loginRequest
+
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.log.WithField("username", req.Username).Debug("Login")
+
+	client, auth, err := s.bridge.LoginAuth(context.Background(), req.Username, []byte(req.Password))
+	if err != nil {
+		if errors.Is(err, bridge.ErrUserAlreadyLoggedIn) {
+			writeError(w, http.StatusConflict, err)
+		} else if apiErr := new(proton.APIError); errors.As(err, &apiErr) {
+			writeError(w, http.StatusUnauthorized, err)
+		} else {
+			writeError(w, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	login := &pendingLogin{
+		client:    client,
+		auth:      auth,
+		password:  []byte(req.Password),
+		startedAt: time.Now(),
+	}
+
+	switch {
+	case auth.TwoFA.Enabled&proton.HasTOTP != 0:
+		writeJSON(w, http.StatusAccepted, loginResponse{Next: loginStepTwoFactor, LoginToken: s.putLogin(uuid.NewString(), login)})
+
+	case auth.PasswordMode == proton.TwoPasswordMode:
+		writeJSON(w, http.StatusAccepted, loginResponse{Next: loginStepMailboxPassword, LoginToken: s.putLogin(uuid.NewString(), login)})
+
+	default:
+		s.finishLogin(w, login)
+	}
+}
+
+// handleLoginStep handles the /v1/login/{loginToken}[/step] endpoints, which continue or abort an unfinished login.
+func (s *Server) handleLoginStep(w http.ResponseWriter, r *http.Request) {
+	token, step, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/login/"), "/")
+
+	switch step {
+	case "":
+		s.handleLoginAbort(w, r, token)
+
+	case string(loginStepTwoFactor):
+		s.handleLogin2FA(w, r, token)
+
+	case string(loginStepMailboxPassword):
+		s.handleLoginMailboxPassword(w, r, token)
+
+	default:
+		writeError(w, http.StatusNotFound, errors.New("no such endpoint"))
+	}
+}
+
+// handleLoginAbort handles DELETE /v1/login/{loginToken}.
+func (s *Server) handleLoginAbort(w http.ResponseWriter, r *http.Request, token string) {
+	if r.Method != http.MethodDelete {
+		writeMethodNotAllowed(w, http.MethodDelete)
+		return
+	}
+
+	s.log.Debug("LoginAbort")
+
+	login, ok := s.takeLogin(token)
+	if !ok {
+		writeError(w, http.StatusNotFound, errNoSuchLogin)
+		return
+	}
+
+	login.discard()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleLogin2FA handles POST /v1/login/{loginToken}/2fa.
+func (s *Server) handleLogin2FA(w http.ResponseWriter, r *http.Request, token string) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req login2FARequest
+
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.log.Debug("Login2FA")
+
+	login, ok := s.takeLogin(token)
+	if !ok {
+		writeError(w, http.StatusNotFound, errNoSuchLogin)
+		return
+	}
+
+	if err := login.client.Auth2FA(context.Background(), proton.Auth2FAReq{TwoFactorCode: req.Code}); err != nil {
+		if apiErr := new(proton.APIError); errors.As(err, &apiErr) && apiErr.Code == proton.PasswordWrong {
+			s.log.Warn("Login 2FA: retry 2fa")
+			s.putLogin(token, login)
+			writeError(w, http.StatusUnauthorized, err)
+		} else {
+			s.log.WithError(err).Warn("Login 2FA: failed")
+			login.discard()
+			writeError(w, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	if login.auth.PasswordMode == proton.TwoPasswordMode {
+		writeJSON(w, http.StatusAccepted, loginResponse{Next: loginStepMailboxPassword, LoginToken: s.putLogin(token, login)})
+		return
+	}
+
+	s.finishLogin(w, login)
+}
+
+// handleLoginMailboxPassword handles POST /v1/login/{loginToken}/mailbox-password.
+func (s *Server) handleLoginMailboxPassword(w http.ResponseWriter, r *http.Request, token string) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req loginMailboxPasswordRequest
+
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.log.Debug("LoginMailboxPassword")
+
+	login, ok := s.takeLogin(token)
+	if !ok {
+		writeError(w, http.StatusNotFound, errNoSuchLogin)
+		return
+	}
+
+	login.clean()
+	login.password = []byte(req.Password)
+
+	s.finishLogin(w, login)
+}
+
+// finishLogin completes the given login, which must no longer be pending.
+func (s *Server) finishLogin(w http.ResponseWriter, login *pendingLogin) {
+	defer login.clean()
+
+	userID, err := s.bridge.LoginUser(context.Background(), login.client, login.auth, login.password)
+	if err != nil {
+		s.log.WithError(err).Errorf("Finish login failed")
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	s.log.WithField("userID", userID).Debug("Login finished")
+
+	writeJSON(w, http.StatusOK, loginResponse{Next: loginStepDone, UserID: userID})
+}
+
+// putLogin stores the given unfinished login under the given token, which it returns.
+// Logins which have not been finished in time are discarded.
+func (s *Server) putLogin(token string, login *pendingLogin) string {
+	s.loginsLock.Lock()
+	defer s.loginsLock.Unlock()
+
+	for other, pending := range s.logins {
+		if time.Since(pending.startedAt) > loginTimeout {
+			pending.discard()
+			delete(s.logins, other)
+		}
+	}
+
+	s.logins[token] = login
+
+	return token
+}
+
+// takeLogin removes the unfinished login with the given token and returns it.
+// While a step of the login is handled, other requests with the same token are thus rejected.
+func (s *Server) takeLogin(token string) (*pendingLogin, bool) {
+	s.loginsLock.Lock()
+	defer s.loginsLock.Unlock()
+
+	login, ok := s.logins[token]
+	if !ok {
+		return nil, false
+	}
+
+	delete(s.logins, token)
+
+	if time.Since(login.startedAt) > loginTimeout {
+		login.discard()
+		return nil, false
+	}
+
+	return login, true
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+)
+
+// handleMailServerSettings handles GET and PUT /v1/settings/mail-server.
+// Fields omitted from a PUT request are left unchanged.
+func (s *Server) handleMailServerSettings(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.log.Debug("MailServerSettings")
+
+		writeJSON(w, http.StatusOK, s.getMailServerSettings())
+
+	case http.MethodPut:
+		req := s.getMailServerSettings()
+
+		if err := readJSON(w, r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		s.log.
+			WithField("IMAPPort", req.IMAPPort).
+			WithField("SMTPPort", req.SMTPPort).
+			WithField("IMAPSSL", req.IMAPSSL).
+			WithField("SMTPSSL", req.SMTPSSL).
+			Debug("SetMailServerSettings")
+
+		if err := s.setMailServerSettings(context.Background(), req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, s.getMailServerSettings())
+
+	default:
+		writeMethodNotAllowed(w, http.MethodGet, http.MethodPut)
+	}
+}
+
+func (s *Server) setMailServerSettings(ctx context.Context, settings mailServerSettings) error {
+	if settings.IMAPPort == settings.SMTPPort {
+		return fmt.Errorf("IMAP and SMTP ports must be different")
+	}
+
+	if s.bridge.GetIMAPSSL() != settings.IMAPSSL {
+		if err := s.bridge.SetIMAPSSL(ctx, settings.IMAPSSL); err != nil {
+			return fmt.Errorf("failed to set IMAP SSL: %w", err)
+		}
+	}
+
+	if s.bridge.GetSMTPSSL() != settings.SMTPSSL {
+		if err := s.bridge.SetSMTPSSL(ctx, settings.SMTPSSL); err != nil {
+			return fmt.Errorf("failed to set SMTP SSL: %w", err)
+		}
+	}
+
+	if s.bridge.GetIMAPPort() != settings.IMAPPort {
+		if !ports.IsPortFree(settings.IMAPPort) {
+			return fmt.Errorf("IMAP port %v is not available", settings.IMAPPort)
+		}
+
+		if err := s.bridge.SetIMAPPort(ctx, settings.IMAPPort); err != nil {
+			return fmt.Errorf("failed to set IMAP port: %w", err)
+		}
+	}
+
+	if s.bridge.GetSMTPPort() != settings.SMTPPort {
+		if !ports.IsPortFree(settings.SMTPPort) {
+			return fmt.Errorf("SMTP port %v is not available", settings.SMTPPort)
+		}
+
+		if err := s.bridge.SetSMTPPort(ctx, settings.SMTPPort); err != nil {
+			return fmt.Errorf("failed to set SMTP port: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) getMailServerSettings() mailServerSettings {
+	return mailServerSettings{
+		IMAPPort: s.bridge.GetIMAPPort(),
+		SMTPPort: s.bridge.GetSMTPPort(),
+		IMAPSSL:  s.bridge.GetIMAPSSL(),
+		SMTPSSL:  s.bridge.GetSMTPSSL(),
+	}
+}
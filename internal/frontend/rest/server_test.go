@@ -0,0 +1,349 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testToken    = "token"
+	testPassword = "password"
+	testTOTP     = "123456"
+)
+
+func TestServer_RejectsInvalidToken(t *testing.T) {
+	handler := newTestServer(t, newTestBridge(t)).newHandler()
+
+	for _, token := range []string{"", "wrong", testToken + "x"} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/users", nil)
+
+		if token != "" {
+			req.Header.Set("Authorization", authorizationPrefix+token)
+		}
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+}
+
+func TestServer_ListUsers(t *testing.T) {
+	b := newTestBridge(t)
+	b.users["userID"] = bridge.UserInfo{
+		UserID:     "userID",
+		Username:   "username",
+		State:      bridge.Connected,
+		Addresses:  []string{"username@pm.me"},
+		BridgePass: []byte("bridge password"),
+	}
+
+	handler := newTestServer(t, b).newHandler()
+
+	var users userListResponse
+	require.Equal(t, http.StatusOK, doRequest(t, handler, http.MethodGet, "/v1/users", nil, &users))
+	require.Equal(t, []userResponse{{
+		UserID:    "userID",
+		Username:  "username",
+		State:     "connected",
+		Addresses: []string{"username@pm.me"},
+	}}, users.Users)
+
+	// The bridge password is only returned for a single user.
+	var user userResponse
+	require.Equal(t, http.StatusOK, doRequest(t, handler, http.MethodGet, "/v1/users/userID", nil, &user))
+	require.Equal(t, "bridge password", user.BridgePass)
+
+	require.Equal(t, http.StatusNotFound, doRequest(t, handler, http.MethodGet, "/v1/users/otherID", nil, nil))
+}
+
+func TestServer_Login(t *testing.T) {
+	b := newTestBridge(t)
+	handler := newTestServer(t, b).newHandler()
+
+	var res loginResponse
+	require.Equal(t, http.StatusOK, doRequest(t, handler, http.MethodPost, "/v1/login", loginRequest{Username: "user", Password: testPassword}, &res))
+	require.Equal(t, loginResponse{Next: loginStepDone, UserID: "user-ID"}, res)
+	require.Equal(t, testPassword, b.getKeyPass("user-ID"))
+
+	// Wrong credentials are rejected.
+	require.Equal(t, http.StatusUnauthorized, doRequest(t, handler, http.MethodPost, "/v1/login", loginRequest{Username: "user", Password: "wrong"}, nil))
+}
+
+func TestServer_Login2FA(t *testing.T) {
+	b := newTestBridge(t)
+	handler := newTestServer(t, b).newHandler()
+
+	var res loginResponse
+	require.Equal(t, http.StatusAccepted, doRequest(t, handler, http.MethodPost, "/v1/login", loginRequest{Username: "totp-user", Password: testPassword}, &res))
+	require.Equal(t, loginStepTwoFactor, res.Next)
+	require.NotEmpty(t, res.LoginToken)
+
+	token := res.LoginToken
+
+	// A wrong code can be retried.
+	require.Equal(t, http.StatusUnauthorized, doRequest(t, handler, http.MethodPost, "/v1/login/"+token+"/2fa", login2FARequest{Code: "000000"}, nil))
+
+	var done loginResponse
+	require.Equal(t, http.StatusOK, doRequest(t, handler, http.MethodPost, "/v1/login/"+token+"/2fa", login2FARequest{Code: testTOTP}, &done))
+	require.Equal(t, loginResponse{Next: loginStepDone, UserID: "totp-user-ID"}, done)
+
+	// The login is over.
+	require.Equal(t, http.StatusNotFound, doRequest(t, handler, http.MethodPost, "/v1/login/"+token+"/2fa", login2FARequest{Code: testTOTP}, nil))
+}
+
+func TestServer_LoginAbort(t *testing.T) {
+	handler := newTestServer(t, newTestBridge(t)).newHandler()
+
+	var res loginResponse
+	require.Equal(t, http.StatusAccepted, doRequest(t, handler, http.MethodPost, "/v1/login", loginRequest{Username: "totp-user", Password: testPassword}, &res))
+
+	require.Equal(t, http.StatusNoContent, doRequest(t, handler, http.MethodDelete, "/v1/login/"+res.LoginToken, nil, nil))
+	require.Equal(t, http.StatusNotFound, doRequest(t, handler, http.MethodPost, "/v1/login/"+res.LoginToken+"/2fa", login2FARequest{Code: testTOTP}, nil))
+	require.Equal(t, http.StatusNotFound, doRequest(t, handler, http.MethodDelete, "/v1/login/unknown", nil, nil))
+}
+
+func TestServer_ConcurrentLogins(t *testing.T) {
+	b := newTestBridge(t)
+	handler := newTestServer(t, b).newHandler()
+
+	// Two logins are started, each requiring the mailbox password.
+	var res1, res2 loginResponse
+	require.Equal(t, http.StatusAccepted, doRequest(t, handler, http.MethodPost, "/v1/login", loginRequest{Username: "mbox-user1", Password: testPassword}, &res1))
+	require.Equal(t, http.StatusAccepted, doRequest(t, handler, http.MethodPost, "/v1/login", loginRequest{Username: "mbox-user2", Password: testPassword}, &res2))
+	require.Equal(t, loginStepMailboxPassword, res1.Next)
+	require.Equal(t, loginStepMailboxPassword, res2.Next)
+	require.NotEqual(t, res1.LoginToken, res2.LoginToken)
+
+	// They are finished in the reverse order, each with its own state.
+	var done1, done2 loginResponse
+	require.Equal(t, http.StatusOK, doRequest(t, handler, http.MethodPost, "/v1/login/"+res2.LoginToken+"/mailbox-password", loginMailboxPasswordRequest{Password: "mbox2"}, &done2))
+	require.Equal(t, http.StatusOK, doRequest(t, handler, http.MethodPost, "/v1/login/"+res1.LoginToken+"/mailbox-password", loginMailboxPasswordRequest{Password: "mbox1"}, &done1))
+
+	require.Equal(t, "mbox-user1-ID", done1.UserID)
+	require.Equal(t, "mbox-user2-ID", done2.UserID)
+	require.Equal(t, "mbox1", b.getKeyPass("mbox-user1-ID"))
+	require.Equal(t, "mbox2", b.getKeyPass("mbox-user2-ID"))
+}
+
+// doRequest makes an authenticated request to the handler and decodes the response into res if it isn't nil.
+func doRequest(t *testing.T, handler http.Handler, method, path string, body, res any) int {
+	t.Helper()
+
+	var b []byte
+
+	if body != nil {
+		var err error
+
+		b, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(b))
+	req.Header.Set("Authorization", authorizationPrefix+testToken)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if res != nil {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+	}
+
+	return rec.Code
+}
+
+func newTestServer(_ *testing.T, b Bridge) *Server {
+	return &Server{
+		token:  testToken,
+		bridge: b,
+		logins: make(map[string]*pendingLogin),
+		log:    logrus.WithField("pkg", "rest"),
+	}
+}
+
+// testBridge is a bridge whose users log in with testPassword; those whose name starts with "totp-" have 2FA enabled
+// and those whose name starts with "mbox-" use a mailbox password. The 2FA step is handled by a fake API.
+type testBridge struct {
+	manager *proton.Manager
+
+	users    map[string]bridge.UserInfo
+	keyPass  map[string]string
+	userLock sync.Mutex
+}
+
+func newTestBridge(t *testing.T) *testBridge {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req proton.Auth2FAReq
+
+		if r.URL.Path != "/auth/v4/2fa" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if req.TwoFactorCode != testTOTP {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_ = json.NewEncoder(w).Encode(proton.APIError{Code: proton.PasswordWrong, Message: "Incorrect login credentials"})
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"Code": 1000})
+	}))
+	t.Cleanup(api.Close)
+
+	manager := proton.New(proton.WithHostURL(api.URL), proton.WithRetryCount(0))
+	t.Cleanup(manager.Close)
+
+	return &testBridge{
+		manager: manager,
+		users:   make(map[string]bridge.UserInfo),
+		keyPass: make(map[string]string),
+	}
+}
+
+func (b *testBridge) LoginAuth(_ context.Context, username string, password []byte) (*proton.Client, proton.Auth, error) {
+	if string(password) != testPassword {
+		return nil, proton.Auth{}, &proton.APIError{Status: http.StatusUnprocessableEntity, Code: proton.PasswordWrong}
+	}
+
+	auth := proton.Auth{UserID: username + "-ID", UID: username + "-UID", PasswordMode: proton.OnePasswordMode}
+
+	if strings.HasPrefix(username, "totp-") {
+		auth.TwoFA.Enabled = proton.HasTOTP
+	}
+
+	if strings.HasPrefix(username, "mbox-") {
+		auth.PasswordMode = proton.TwoPasswordMode
+	}
+
+	return b.manager.NewClient(auth.UID, "access", "refresh"), auth, nil
+}
+
+func (b *testBridge) LoginUser(_ context.Context, client *proton.Client, auth proton.Auth, keyPass []byte) (string, error) {
+	defer client.Close()
+
+	b.userLock.Lock()
+	defer b.userLock.Unlock()
+
+	b.users[auth.UserID] = bridge.UserInfo{UserID: auth.UserID, State: bridge.Connected}
+	b.keyPass[auth.UserID] = string(keyPass)
+
+	return auth.UserID, nil
+}
+
+func (b *testBridge) getKeyPass(userID string) string {
+	b.userLock.Lock()
+	defer b.userLock.Unlock()
+
+	return b.keyPass[userID]
+}
+
+func (b *testBridge) GetUserIDs() []string {
+	b.userLock.Lock()
+	defer b.userLock.Unlock()
+
+	userIDs := make([]string, 0, len(b.users))
+
+	for userID := range b.users {
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs
+}
+
+func (b *testBridge) GetUserInfo(userID string) (bridge.UserInfo, error) {
+	b.userLock.Lock()
+	defer b.userLock.Unlock()
+
+	user, ok := b.users[userID]
+	if !ok {
+		return bridge.UserInfo{}, bridge.ErrNoSuchUser
+	}
+
+	return user, nil
+}
+
+func (b *testBridge) HasUser(userID string) bool {
+	_, err := b.GetUserInfo(userID)
+	return err == nil
+}
+
+func (b *testBridge) LogoutUser(context.Context, string) error {
+	return nil
+}
+
+func (b *testBridge) DeleteUser(context.Context, string) error {
+	return nil
+}
+
+func (b *testBridge) SetAddressMode(context.Context, string, vault.AddressMode) error {
+	return nil
+}
+
+func (b *testBridge) GetIMAPPort() int {
+	return 1143
+}
+
+func (b *testBridge) SetIMAPPort(context.Context, int) error {
+	return nil
+}
+
+func (b *testBridge) GetIMAPSSL() bool {
+	return false
+}
+
+func (b *testBridge) SetIMAPSSL(context.Context, bool) error {
+	return nil
+}
+
+func (b *testBridge) GetSMTPPort() int {
+	return 1025
+}
+
+func (b *testBridge) SetSMTPPort(context.Context, int) error {
+	return nil
+}
+
+func (b *testBridge) GetSMTPSSL() bool {
+	return false
+}
+
+func (b *testBridge) SetSMTPSSL(context.Context, bool) error {
+	return nil
+}
+
+func (b *testBridge) GetEvents(...events.Event) (<-chan events.Event, context.CancelFunc) {
+	return make(chan events.Event), func() {}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
+
+// handleUsers handles GET /v1/users.
+func (s *Server) handleUsers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	s.log.Debug("GetUserList")
+
+	userIDs := s.bridge.GetUserIDs()
+	userList := make([]userResponse, 0, len(userIDs))
+
+	for _, userID := range userIDs {
+		user, err := s.bridge.GetUserInfo(userID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		userList = append(userList, restUserFromInfo(user, false))
+	}
+
+	writeJSON(w, http.StatusOK, userListResponse{Users: userList})
+}
+
+// handleUser handles the /v1/users/{userID}[/action] endpoints.
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	userID, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/users/"), "/")
+
+	if !s.bridge.HasUser(userID) {
+		writeError(w, http.StatusNotFound, bridge.ErrNoSuchUser)
+		return
+	}
+
+	switch action {
+	case "":
+		s.handleUserInfo(w, r, userID)
+
+	case "logout":
+		s.handleUserLogout(w, r, userID)
+
+	case "split-mode":
+		s.handleUserSplitMode(w, r, userID)
+
+	default:
+		writeError(w, http.StatusNotFound, errors.New("no such endpoint"))
+	}
+}
+
+// handleUserInfo handles GET and DELETE /v1/users/{userID}.
+func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request, userID string) {
+	switch r.Method {
+	case http.MethodGet:
+		s.log.WithField("userID", userID).Debug("GetUser")
+
+		user, err := s.bridge.GetUserInfo(userID)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, restUserFromInfo(user, true))
+
+	case http.MethodDelete:
+		s.log.WithField("userID", userID).Debug("RemoveUser")
+
+		if err := s.bridge.DeleteUser(context.Background(), userID); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeMethodNotAllowed(w, http.MethodGet, http.MethodDelete)
+	}
+}
+
+// handleUserLogout handles POST /v1/users/{userID}/logout.
+func (s *Server) handleUserLogout(w http.ResponseWriter, r *http.Request, userID string) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	s.log.WithField("userID", userID).Debug("LogoutUser")
+
+	if err := s.bridge.LogoutUser(context.Background(), userID); err != nil {
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			writeError(w, http.StatusConflict, errors.New("user is not logged in"))
+		} else {
+			writeError(w, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleUserSplitMode handles PUT /v1/users/{userID}/split-mode.
+func (s *Server) handleUserSplitMode(w http.ResponseWriter, r *http.Request, userID string) {
+	if r.Method != http.MethodPut {
+		writeMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	var req splitModeRequest
+
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.log.WithField("userID", userID).WithField("active", req.Active).Debug("SetUserSplitMode")
+
+	user, err := s.bridge.GetUserInfo(userID)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	targetMode := vault.CombinedMode
+	if req.Active {
+		targetMode = vault.SplitMode
+	}
+
+	if user.AddressMode != targetMode {
+		if err := s.bridge.SetAddressMode(context.Background(), userID, targetMode); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type userResponse struct {
+	UserID     string   `json:"userID"`
+	Username   string   `json:"username"`
+	State      string   `json:"state"`
+	Addresses  []string `json:"addresses"`
+	SplitMode  bool     `json:"splitMode"`
+	BridgePass string   `json:"bridgePassword,omitempty"`
+	UsedSpace  int      `json:"usedSpace"`
+	MaxSpace   int      `json:"maxSpace"`
+}
+
+type userListResponse struct {
+	Users []userResponse `json:"users"`
+}
+
+type splitModeRequest struct {
+	Active bool `json:"active"`
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type login2FARequest struct {
+	Code string `json:"code"`
+}
+
+type loginMailboxPasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// loginStep is the next step required to finish an ongoing login.
+type loginStep string
+
+const (
+	loginStepTwoFactor       loginStep = "2fa"
+	loginStepMailboxPassword loginStep = "mailbox-password"
+	loginStepDone            loginStep = "done"
+)
+
+type loginResponse struct {
+	Next       loginStep `json:"next"`
+	LoginToken string    `json:"loginToken,omitempty"` // Identifies the login in the requests for the next step.
+	UserID     string    `json:"userID,omitempty"`
+}
+
+type mailServerSettings struct {
+	IMAPPort int  `json:"imapPort"`
+	SMTPPort int  `json:"smtpPort"`
+	IMAPSSL  bool `json:"imapSSL"`
+	SMTPSSL  bool `json:"smtpSSL"`
+}
+
+type eventResponse struct {
+	Type        string         `json:"type"`
+	Description string         `json:"description"`
+	Data        map[string]any `json:"data"`
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/sirupsen/logrus"
+)
+
+// maxRequestSize is the maximum size of a request body accepted by the API.
+const maxRequestSize = 1 << 16
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize))
+
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logrus.WithError(err).Error("Failed to write REST API response")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method not allowed, use %v", strings.Join(allowed, " or ")))
+}
+
+// restUserFromInfo converts a bridge user to a REST API user.
+func restUserFromInfo(user bridge.UserInfo, withPassword bool) userResponse {
+	res := userResponse{
+		UserID:    user.UserID,
+		Username:  user.Username,
+		State:     userStateToString(user.State),
+		Addresses: user.Addresses,
+		SplitMode: user.AddressMode == vault.SplitMode,
+		UsedSpace: user.UsedSpace,
+		MaxSpace:  user.MaxSpace,
+	}
+
+	if withPassword {
+		res.BridgePass = string(user.BridgePass)
+	}
+
+	return res
+}
+
+func userStateToString(state bridge.UserState) string {
+	switch state {
+	case bridge.SignedOut:
+		return "signed-out"
+	case bridge.Locked:
+		return "locked"
+	case bridge.Connected:
+		return "connected"
+	default:
+		return "unknown"
+	}
+}
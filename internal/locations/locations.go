@@ -35,6 +35,7 @@ import (
 // - logs:     ~/.local/share/protonmail/<app>/logs
 // - updates:  ~/.local/share/protonmail/<app>/updates
 // - locks:    ~/.cache/protonmail/<app>/*.lock
+// - sockets:  $XDG_RUNTIME_DIR/protonmail/<app>/*.sock
 // Other OSes are similar.
 type Locations struct {
 	// userConfig is the path to the user config directory, for storing persistent config data.
@@ -46,6 +47,9 @@ type Locations struct {
 	// userCache is the path to the user cache directory, for storing non-essential data.
 	userCache string
 
+	// userRuntime is the path to the user runtime directory, for storing sockets.
+	userRuntime string
+
 	configName    string
 	configGuiName string
 }
@@ -53,9 +57,10 @@ type Locations struct {
 // New returns a new locations object.
 func New(provider Provider, configName string) *Locations {
 	return &Locations{
-		userConfig: provider.UserConfig(),
-		userData:   provider.UserData(),
-		userCache:  provider.UserCache(),
+		userConfig:  provider.UserConfig(),
+		userData:    provider.UserData(),
+		userCache:   provider.UserCache(),
+		userRuntime: provider.UserRuntime(),
 
 		configName:    configName,
 		configGuiName: configName + "-gui",
@@ -198,6 +203,16 @@ func (l *Locations) ProvideStatsPath() (string, error) {
 	return l.getStatsPath(), nil
 }
 
+// ProvideRuntimePath returns a location for runtime files such as sockets (e.g. $XDG_RUNTIME_DIR/<company>/<app>).
+// It creates it if it doesn't already exist.
+func (l *Locations) ProvideRuntimePath() (string, error) {
+	if err := os.MkdirAll(l.userRuntime, 0o700); err != nil {
+		return "", err
+	}
+
+	return l.userRuntime, nil
+}
+
 func (l *Locations) getGluonCachePath() string {
 	return filepath.Join(l.userData, "gluon")
 }
@@ -27,7 +27,7 @@ import (
 )
 
 type fakeAppDirs struct {
-	configDir, dataDir, cacheDir string
+	configDir, dataDir, cacheDir, runtimeDir string
 }
 
 func (dirs *fakeAppDirs) UserConfig() string {
@@ -42,6 +42,10 @@ func (dirs *fakeAppDirs) UserCache() string {
 	return dirs.cacheDir
 }
 
+func (dirs *fakeAppDirs) UserRuntime() string {
+	return dirs.runtimeDir
+}
+
 func TestClearRemovesEverythingExceptLockAndUpdateFiles(t *testing.T) {
 	l := newTestLocations(t)
 
@@ -88,9 +92,10 @@ func TestRemoveOldGoIMAPCacheFolders(t *testing.T) {
 
 func newFakeAppDirs(t *testing.T) *fakeAppDirs {
 	return &fakeAppDirs{
-		configDir: t.TempDir(),
-		dataDir:   t.TempDir(),
-		cacheDir:  t.TempDir(),
+		configDir:  t.TempDir(),
+		dataDir:    t.TempDir(),
+		cacheDir:   t.TempDir(),
+		runtimeDir: t.TempDir(),
 	}
 }
 
@@ -124,3 +129,17 @@ func createFilesInDir(t *testing.T, dir string, files ...string) {
 		require.NoError(t, f.Close())
 	}
 }
+
+func TestProvideRuntimePath(t *testing.T) {
+	dirs := newFakeAppDirs(t)
+
+	require.NoError(t, os.Remove(dirs.runtimeDir))
+
+	path, err := New(dirs, "configName").ProvideRuntimePath()
+	require.NoError(t, err)
+	require.Equal(t, dirs.runtimeDir, path)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+}
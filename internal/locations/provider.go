@@ -29,11 +29,12 @@ type Provider interface {
 	UserConfig() string
 	UserData() string
 	UserCache() string
+	UserRuntime() string
 }
 
 // DefaultProvider is a locations provider using the system-default storage locations.
 type DefaultProvider struct {
-	config, data, cache string
+	config, data, cache, runtime string
 }
 
 func NewDefaultProvider(name string) (*DefaultProvider, error) {
@@ -53,9 +54,10 @@ func NewDefaultProvider(name string) (*DefaultProvider, error) {
 	}
 
 	provider := &DefaultProvider{
-		config: filepath.Join(config, name),
-		data:   filepath.Join(data, name),
-		cache:  filepath.Join(cache, name),
+		config:  filepath.Join(config, name),
+		data:    filepath.Join(data, name),
+		cache:   filepath.Join(cache, name),
+		runtime: filepath.Join(userRuntimeDir(cache), name),
 	}
 
 	if err := os.MkdirAll(provider.config, 0o700); err != nil {
@@ -70,6 +72,10 @@ func NewDefaultProvider(name string) (*DefaultProvider, error) {
 		return nil, err
 	}
 
+	if err := os.MkdirAll(provider.runtime, 0o700); err != nil {
+		return nil, err
+	}
+
 	return provider, nil
 }
 
@@ -91,6 +97,12 @@ func (p *DefaultProvider) UserCache() string {
 	return p.cache
 }
 
+// UserRuntime returns a directory that can be used to store user-specific runtime files such as sockets.
+// $XDG_RUNTIME_DIR/protonmail is used on Linux if it is defined; the user cache directory is used otherwise.
+func (p *DefaultProvider) UserRuntime() string {
+	return p.runtime
+}
+
 // userDataDir returns a directory that can be used to store user-specific data.
 // This is necessary because os.UserDataDir() is not implemented by the Go standard library, sadly.
 // On non-linux systems, it is the same as os.UserConfigDir().
@@ -109,3 +121,15 @@ func userDataDir() (string, error) {
 
 	return "", errors.New("neither $XDG_DATA_HOME nor $HOME are defined")
 }
+
+// userRuntimeDir returns a directory that can be used to store user-specific runtime files.
+// This is $XDG_RUNTIME_DIR if defined, which is only readable by the user; otherwise it is the given cache directory.
+func userRuntimeDir(cache string) string {
+	if runtime.GOOS == "linux" {
+		if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+			return dir
+		}
+	}
+
+	return cache
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package scram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emersion/go-sasl"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+type client struct {
+	username string
+	password string
+
+	// gs2Header is sent at the start of the exchange and tells whether the client binds the authentication to the connection.
+	gs2Header string
+	binding   []byte
+
+	step step
+
+	clientFirstBare string
+	nonce           string
+	serverSignature []byte
+}
+
+// NewClient returns a client for SCRAM-SHA-256.
+func NewClient(username, password string) sasl.Client {
+	return &client{username: username, password: password, gs2Header: "n,,"}
+}
+
+// NewPlusClient returns a client for SCRAM-SHA-256-PLUS, which binds the authentication to the connection
+// with the given channel binding data.
+func NewPlusClient(username, password string, binding []byte) sasl.Client {
+	return &client{username: username, password: password, gs2Header: "p=" + channelBindingType + ",,", binding: binding}
+}
+
+func (c *client) Start() (string, []byte, error) {
+	mech := SHA256
+	if strings.HasPrefix(c.gs2Header, "p=") {
+		mech = SHA256Plus
+	}
+
+	c.nonce = base64.RawStdEncoding.EncodeToString(randomBytes(nonceLength))
+	c.clientFirstBare = "n=" + encodeUsername(c.username) + ",r=" + c.nonce
+	c.step = stepClientFinal
+
+	return mech, []byte(c.gs2Header + c.clientFirstBare), nil
+}
+
+func (c *client) Next(challenge []byte) ([]byte, error) {
+	switch c.step {
+	case stepClientFinal:
+		return c.handleServerFirst(string(challenge))
+
+	case stepServerFinal:
+		if !hmac.Equal(challenge, []byte("v="+base64.StdEncoding.EncodeToString(c.serverSignature))) {
+			return nil, errors.New("invalid server signature")
+		}
+
+		c.step = stepDone
+
+		// The server only reports the success once it has got an empty response.
+		return []byte{}, nil
+
+	default:
+		return nil, sasl.ErrUnexpectedServerChallenge
+	}
+}
+
+func (c *client) handleServerFirst(serverFirst string) ([]byte, error) {
+	attrs := strings.Split(serverFirst, ",")
+	if len(attrs) < 3 || !strings.HasPrefix(attrs[0], "r=") || !strings.HasPrefix(attrs[1], "s=") || !strings.HasPrefix(attrs[2], "i=") {
+		return nil, errMalformed
+	}
+
+	nonce := strings.TrimPrefix(attrs[0], "r=")
+	if !strings.HasPrefix(nonce, c.nonce) {
+		return nil, errors.New("nonce mismatch")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(attrs[1], "s="))
+	if err != nil {
+		return nil, errMalformed
+	}
+
+	iterations, err := strconv.Atoi(strings.TrimPrefix(attrs[2], "i="))
+	if err != nil || iterations <= 0 {
+		return nil, fmt.Errorf("invalid iteration count: %w", errMalformed)
+	}
+
+	withoutProof := "c=" + base64.StdEncoding.EncodeToString(append([]byte(c.gs2Header), c.binding...)) + ",r=" + nonce
+	authMessage := c.clientFirstBare + "," + serverFirst + "," + withoutProof
+
+	saltedPassword := pbkdf2.Key([]byte(c.password), salt, iterations, sha256.Size, sha256.New)
+	clientKey := computeHMAC(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := computeHMAC(storedKey[:], authMessage)
+
+	proof := make([]byte, len(clientKey))
+
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+
+	c.serverSignature = computeHMAC(computeHMAC(saltedPassword, "Server Key"), authMessage)
+	c.step = stepServerFinal
+
+	return []byte(withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)), nil
+}
+
+// encodeUsername escapes "," and "=" in a username as "=2C" and "=3D".
+func encodeUsername(username string) string {
+	return strings.NewReplacer("=", "=3D", ",", "=2C").Replace(username)
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package scram implements the server side of the SCRAM-SHA-256 and SCRAM-SHA-256-PLUS SASL mechanisms
+// (RFC 5802, RFC 7677), with which clients prove that they know a password without sending it.
+package scram
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emersion/go-sasl"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	SHA256     = "SCRAM-SHA-256"
+	SHA256Plus = "SCRAM-SHA-256-PLUS"
+
+	// Iterations is the iteration count with which passwords are salted, the one recommended by RFC 7677.
+	Iterations = 4096
+
+	// ChannelBindingLabel is the label with which the channel binding data is exported from the TLS connection (RFC 9266).
+	ChannelBindingLabel = "EXPORTER-Channel-Binding"
+
+	// ChannelBindingLength is the length, in bytes, of the channel binding data.
+	ChannelBindingLength = 32
+
+	// channelBindingType is the only type of channel binding supported.
+	channelBindingType = "tls-exporter"
+
+	saltLength  = 16
+	nonceLength = 18
+)
+
+var (
+	// ErrInvalidProof is returned when the client doesn't know any of the user's passwords.
+	ErrInvalidProof = errors.New("invalid proof")
+
+	// ErrChannelBinding is returned when the channel binding requested by the client doesn't match the connection.
+	ErrChannelBinding = errors.New("channel binding mismatch")
+
+	errMalformed = errors.New("malformed message")
+)
+
+// Verifier is what is stored to check a password, from which the password itself can't be recovered.
+type Verifier struct {
+	StoredKey []byte
+	ServerKey []byte
+}
+
+// Credentials are the verifiers of the passwords with which a user can authenticate.
+// They must all be derived with the same salt and iteration count,
+// as those are sent to the client before it proves which password it knows.
+type Credentials struct {
+	Salt       []byte
+	Iterations int
+	Verifiers  []Verifier
+}
+
+// NewSalt returns a new random salt.
+func NewSalt() []byte {
+	return randomBytes(saltLength)
+}
+
+// NewVerifier derives the verifier of the given password.
+// The password isn't normalized with SASLprep, which leaves the ASCII passwords generated by the bridge as they are.
+func NewVerifier(password string, salt []byte, iterations int) Verifier {
+	saltedPassword := pbkdf2.Key([]byte(password), salt, iterations, sha256.Size, sha256.New)
+
+	storedKey := sha256.Sum256(computeHMAC(saltedPassword, "Client Key"))
+
+	return Verifier{
+		StoredKey: storedKey[:],
+		ServerKey: computeHMAC(saltedPassword, "Server Key"),
+	}
+}
+
+// LookupFunc returns the credentials of the given user.
+type LookupFunc func(username string) (Credentials, error)
+
+// AuthenticateFunc is called once the client has proved that it knows the password of the given user
+// whose verifier has the given index in the user's credentials.
+type AuthenticateFunc func(username string, verifier int) error
+
+type step int
+
+const (
+	stepClientFirst step = iota
+	stepClientFinal
+	stepServerFinal
+	stepDone
+)
+
+type server struct {
+	// binding is the channel binding data of the connection, or nil if it doesn't support channel binding.
+	binding []byte
+
+	// plus is whether the client must bind the authentication to the connection.
+	plus bool
+
+	lookup       LookupFunc
+	authenticate AuthenticateFunc
+
+	step step
+
+	username        string
+	gs2Header       string
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+	credentials     Credentials
+}
+
+// NewServer returns a server for SCRAM-SHA-256.
+// If the connection supports channel binding, binding is its channel binding data:
+// clients which could have used SCRAM-SHA-256-PLUS then can't be downgraded to SCRAM-SHA-256.
+func NewServer(binding []byte, lookup LookupFunc, authenticate AuthenticateFunc) sasl.Server {
+	return &server{binding: binding, lookup: lookup, authenticate: authenticate}
+}
+
+// NewPlusServer returns a server for SCRAM-SHA-256-PLUS, which binds the authentication to the connection
+// with the given channel binding data; nil if the connection doesn't support channel binding, which always fails.
+func NewPlusServer(binding []byte, lookup LookupFunc, authenticate AuthenticateFunc) sasl.Server {
+	return &server{binding: binding, plus: true, lookup: lookup, authenticate: authenticate}
+}
+
+func (s *server) Next(response []byte) ([]byte, bool, error) {
+	switch s.step {
+	case stepClientFirst:
+		// The client didn't send an initial response; ask for it.
+		if len(response) == 0 {
+			return []byte{}, false, nil
+		}
+
+		return s.handleClientFirst(string(response))
+
+	case stepClientFinal:
+		return s.handleClientFinal(string(response))
+
+	case stepServerFinal:
+		if len(response) != 0 {
+			return nil, false, sasl.ErrUnexpectedClientResponse
+		}
+
+		s.step = stepDone
+
+		return nil, true, nil
+
+	default:
+		return nil, false, sasl.ErrUnexpectedClientResponse
+	}
+}
+
+func (s *server) handleClientFirst(message string) ([]byte, bool, error) {
+	parts := strings.SplitN(message, ",", 3)
+	if len(parts) != 3 {
+		return nil, false, errMalformed
+	}
+
+	if err := s.checkChannelBindingFlag(parts[0]); err != nil {
+		return nil, false, err
+	}
+
+	s.gs2Header = parts[0] + "," + parts[1] + ","
+	s.clientFirstBare = parts[2]
+
+	attrs := strings.Split(s.clientFirstBare, ",")
+	if len(attrs) < 2 || !strings.HasPrefix(attrs[0], "n=") || !strings.HasPrefix(attrs[1], "r=") {
+		return nil, false, errMalformed
+	}
+
+	username, err := decodeUsername(strings.TrimPrefix(attrs[0], "n="))
+	if err != nil {
+		return nil, false, err
+	}
+
+	if authzid := parts[1]; authzid != "" {
+		if decoded, err := decodeUsername(strings.TrimPrefix(authzid, "a=")); err != nil || decoded != username {
+			return nil, false, errors.New("authorization identities are not supported")
+		}
+	}
+
+	clientNonce := strings.TrimPrefix(attrs[1], "r=")
+	if clientNonce == "" {
+		return nil, false, errMalformed
+	}
+
+	credentials, err := s.lookup(username)
+	if err != nil {
+		return nil, false, err
+	}
+
+	s.username = username
+	s.credentials = credentials
+	s.nonce = clientNonce + base64.RawStdEncoding.EncodeToString(randomBytes(nonceLength))
+	s.serverFirst = "r=" + s.nonce +
+		",s=" + base64.StdEncoding.EncodeToString(credentials.Salt) +
+		",i=" + strconv.Itoa(credentials.Iterations)
+	s.step = stepClientFinal
+
+	return []byte(s.serverFirst), false, nil
+}
+
+// checkChannelBindingFlag checks the channel binding flag sent by the client against what the connection supports.
+func (s *server) checkChannelBindingFlag(flag string) error {
+	switch {
+	case flag == "n":
+		if s.plus {
+			return fmt.Errorf("%w: the client doesn't support channel binding", ErrChannelBinding)
+		}
+
+	case flag == "y":
+		// The client supports channel binding but thinks the server doesn't, which may be a downgrade attack.
+		if s.plus || s.binding != nil {
+			return fmt.Errorf("%w: the server supports channel binding", ErrChannelBinding)
+		}
+
+	case strings.HasPrefix(flag, "p="):
+		if !s.plus || s.binding == nil {
+			return fmt.Errorf("%w: channel binding is not supported", ErrChannelBinding)
+		}
+
+		if cbType := strings.TrimPrefix(flag, "p="); cbType != channelBindingType {
+			return fmt.Errorf("%w: unsupported channel binding type %q", ErrChannelBinding, cbType)
+		}
+
+	default:
+		return errMalformed
+	}
+
+	return nil
+}
+
+func (s *server) handleClientFinal(message string) ([]byte, bool, error) {
+	idx := strings.LastIndex(message, ",p=")
+	if idx < 0 {
+		return nil, false, errMalformed
+	}
+
+	withoutProof := message[:idx]
+
+	proof, err := base64.StdEncoding.DecodeString(message[idx+len(",p="):])
+	if err != nil {
+		return nil, false, errMalformed
+	}
+
+	attrs := strings.Split(withoutProof, ",")
+	if len(attrs) < 2 || !strings.HasPrefix(attrs[0], "c=") || !strings.HasPrefix(attrs[1], "r=") {
+		return nil, false, errMalformed
+	}
+
+	binding, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(attrs[0], "c="))
+	if err != nil {
+		return nil, false, errMalformed
+	}
+
+	if !hmac.Equal(binding, s.getChannelBinding()) {
+		return nil, false, ErrChannelBinding
+	}
+
+	if strings.TrimPrefix(attrs[1], "r=") != s.nonce {
+		return nil, false, errors.New("nonce mismatch")
+	}
+
+	authMessage := s.clientFirstBare + "," + s.serverFirst + "," + withoutProof
+
+	// The server can't tell which password the client knows, so every verifier is checked.
+	match := -1
+
+	for i, verifier := range s.credentials.Verifiers {
+		if checkProof(verifier, authMessage, proof) {
+			match = i
+		}
+	}
+
+	if match < 0 {
+		return nil, false, ErrInvalidProof
+	}
+
+	if err := s.authenticate(s.username, match); err != nil {
+		return nil, false, err
+	}
+
+	serverSignature := computeHMAC(s.credentials.Verifiers[match].ServerKey, authMessage)
+
+	s.step = stepServerFinal
+
+	return []byte("v=" + base64.StdEncoding.EncodeToString(serverSignature)), false, nil
+}
+
+// getChannelBinding returns the channel binding the client must send: its GS2 header, followed by the binding data if any.
+func (s *server) getChannelBinding() []byte {
+	if strings.HasPrefix(s.gs2Header, "p=") {
+		return append([]byte(s.gs2Header), s.binding...)
+	}
+
+	return []byte(s.gs2Header)
+}
+
+// checkProof returns whether the client proof was computed with the password of the given verifier.
+func checkProof(verifier Verifier, authMessage string, proof []byte) bool {
+	clientSignature := computeHMAC(verifier.StoredKey, authMessage)
+
+	if len(proof) != len(clientSignature) {
+		return false
+	}
+
+	clientKey := make([]byte, len(proof))
+
+	for i := range proof {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+
+	storedKey := sha256.Sum256(clientKey)
+
+	return subtle.ConstantTimeCompare(storedKey[:], verifier.StoredKey) == 1
+}
+
+// decodeUsername decodes a username, in which "," and "=" are escaped as "=2C" and "=3D".
+func decodeUsername(username string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(username); i++ {
+		if username[i] != '=' {
+			b.WriteByte(username[i])
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(username[i:], "=2C"):
+			b.WriteByte(',')
+
+		case strings.HasPrefix(username[i:], "=3D"):
+			b.WriteByte('=')
+
+		default:
+			return "", errMalformed
+		}
+
+		i += 2
+	}
+
+	if b.Len() == 0 {
+		return "", errMalformed
+	}
+
+	return b.String(), nil
+}
+
+func computeHMAC(key []byte, message string) []byte {
+	mac := hmac.New(sha256.New, key)
+
+	_, _ = mac.Write([]byte(message))
+
+	return mac.Sum(nil)
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return b
+}
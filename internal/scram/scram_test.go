@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package scram
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/emersion/go-sasl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	salt := NewSalt()
+
+	credentials := Credentials{
+		Salt:       salt,
+		Iterations: Iterations,
+		Verifiers: []Verifier{
+			NewVerifier("bridge password", salt, Iterations),
+			NewVerifier("app password", salt, Iterations),
+		},
+	}
+
+	lookup := func(username string) (Credentials, error) {
+		if username != "user,name@pm.me" {
+			return Credentials{}, errors.New("no such user")
+		}
+
+		return credentials, nil
+	}
+
+	var authenticated []int
+
+	authenticate := func(_ string, verifier int) error {
+		authenticated = append(authenticated, verifier)
+		return nil
+	}
+
+	binding := []byte("binding data")
+
+	// Any of the user's passwords can be used, with or without channel binding.
+	require.NoError(t, run(NewServer(nil, lookup, authenticate), NewClient("user,name@pm.me", "bridge password")))
+	require.NoError(t, run(NewServer(binding, lookup, authenticate), NewClient("user,name@pm.me", "app password")))
+	require.NoError(t, run(NewPlusServer(binding, lookup, authenticate), NewPlusClient("user,name@pm.me", "app password", binding)))
+	require.Equal(t, []int{0, 1, 1}, authenticated)
+
+	// Other passwords and users can't.
+	require.ErrorIs(t, run(NewServer(nil, lookup, authenticate), NewClient("user,name@pm.me", "wrong password")), ErrInvalidProof)
+	require.Error(t, run(NewServer(nil, lookup, authenticate), NewClient("other@pm.me", "bridge password")))
+
+	// The channel binding must match the connection.
+	require.ErrorIs(t, run(NewPlusServer(binding, lookup, authenticate), NewPlusClient("user,name@pm.me", "app password", []byte("other"))), ErrChannelBinding)
+	require.ErrorIs(t, run(NewPlusServer(nil, lookup, authenticate), NewPlusClient("user,name@pm.me", "app password", binding)), ErrChannelBinding)
+	require.ErrorIs(t, run(NewPlusServer(binding, lookup, authenticate), NewClient("user,name@pm.me", "app password")), ErrChannelBinding)
+	require.ErrorIs(t, run(NewPlusServer(binding, lookup, authenticate), &client{
+		username:  "user,name@pm.me",
+		password:  "app password",
+		gs2Header: "p=tls-unique,,",
+		binding:   binding,
+	}), ErrChannelBinding)
+
+	// Clients which support channel binding can't be downgraded.
+	require.ErrorIs(t, run(NewServer(binding, lookup, authenticate), &client{
+		username:  "user,name@pm.me",
+		password:  "app password",
+		gs2Header: "y,,",
+	}), ErrChannelBinding)
+
+	require.Equal(t, []int{0, 1, 1}, authenticated)
+}
+
+func TestServer_NoInitialResponse(t *testing.T) {
+	salt := NewSalt()
+
+	server := NewServer(nil, func(string) (Credentials, error) {
+		return Credentials{Salt: salt, Iterations: Iterations, Verifiers: []Verifier{NewVerifier("password", salt, Iterations)}}, nil
+	}, func(string, int) error {
+		return nil
+	})
+
+	challenge, done, err := server.Next(nil)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Empty(t, challenge)
+
+	require.NoError(t, run(server, NewClient("user@pm.me", "password")))
+}
+
+func TestUsernameEncoding(t *testing.T) {
+	username, err := decodeUsername(encodeUsername("a=b,c"))
+	require.NoError(t, err)
+	require.Equal(t, "a=b,c", username)
+
+	_, err = decodeUsername("a=b")
+	require.Error(t, err)
+
+	_, err = decodeUsername("")
+	require.Error(t, err)
+}
+
+// run runs the exchange between the given server and client.
+func run(server sasl.Server, client sasl.Client) error {
+	_, response, err := client.Start()
+	if err != nil {
+		return err
+	}
+
+	for {
+		challenge, done, err := server.Next(response)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		if response, err = client.Next(challenge); err != nil {
+			return err
+		}
+	}
+}
@@ -23,5 +23,10 @@ var (
 	ErrNoSuchAddress     = errors.New("no such address")
 	ErrInvalidReturnPath = errors.New("invalid return path")
 	ErrInvalidRecipient  = errors.New("invalid recipient")
+	ErrNoSuchRecipient   = errors.New("no such recipient")
+	ErrRecipientDisabled = errors.New("recipient address is disabled")
 	ErrMissingAddrKey    = errors.New("missing address key")
+	ErrQuotaExceeded     = errors.New("message exceeds the space left in the account")
+	ErrSenderNotAllowed  = errors.New("the credentials can't be used to send from this address")
+	ErrIMAPNotAllowed    = errors.New("the credentials can't be used with IMAP")
 )
@@ -76,16 +76,46 @@ func newIMAPConnector(user *User, addrID string) *imapConnector {
 }
 
 // Authorize returns whether the given username/password combination are valid for this connector.
+// Credentials restricted to some addresses can't be used in combined mode, in which all addresses are served together.
+// Attempts are refused without checking the credentials if the auth checker doesn't allow them,
+// e.g. while the session's source or the username is locked out,
+// or if the session can't be identified, as the scope of its credentials could then not be enforced.
 func (conn *imapConnector) Authorize(ctx context.Context, username string, password []byte) bool {
-	addrID, err := conn.CheckAuth(username, password)
+	sessionID, ok := imap.GetSessionIDFromContext(ctx)
+	if !ok {
+		conn.log.Error("Could not identify the IMAP session, refusing to authenticate it")
+		return false
+	}
+
+	if !conn.authChecker.AllowIMAPAuth(sessionID, conn.ID(), username) {
+		return false
+	}
+
+	addrID, scope, err := conn.CheckAuth(username, password)
 	if err != nil {
 		return false
 	}
 
-	if conn.vault.AddressMode() == vault.SplitMode && addrID != conn.addrID {
+	if scope.IMAP == vault.IMAPDenied {
 		return false
 	}
 
+	switch conn.vault.AddressMode() {
+	case vault.CombinedMode:
+		if len(scope.Addresses) > 0 {
+			return false
+		}
+
+	case vault.SplitMode:
+		if addrID != conn.addrID {
+			return false
+		}
+	}
+
+	conn.setIMAPSessionScope(sessionID, scope)
+
+	conn.authChecker.IMAPAuthSucceeded(sessionID, username)
+
 	conn.User.SendConfigStatusSuccess(ctx)
 
 	return true
@@ -93,6 +123,10 @@ func (conn *imapConnector) Authorize(ctx context.Context, username string, passw
 
 // CreateMailbox creates a label with the given name.
 func (conn *imapConnector) CreateMailbox(ctx context.Context, name []string) (imap.Mailbox, error) {
+	if err := conn.checkWritable(ctx); err != nil {
+		return imap.Mailbox{}, err
+	}
+
 	defer conn.goPollAPIEvents(false)
 
 	if len(name) < 2 {
@@ -171,6 +205,10 @@ func (conn *imapConnector) createFolder(ctx context.Context, name []string) (ima
 
 // UpdateMailboxName sets the name of the label with the given ID.
 func (conn *imapConnector) UpdateMailboxName(ctx context.Context, labelID imap.MailboxID, name []string) error {
+	if err := conn.checkWritable(ctx); err != nil {
+		return err
+	}
+
 	return safe.LockRet(func() error {
 		defer conn.goPollAPIEvents(false)
 
@@ -254,6 +292,10 @@ func (conn *imapConnector) updateFolder(ctx context.Context, labelID imap.Mailbo
 
 // DeleteMailbox deletes the label with the given ID.
 func (conn *imapConnector) DeleteMailbox(ctx context.Context, labelID imap.MailboxID) error {
+	if err := conn.checkWritable(ctx); err != nil {
+		return err
+	}
+
 	return safe.LockRet(func() error {
 		defer conn.goPollAPIEvents(false)
 
@@ -275,6 +317,10 @@ func (conn *imapConnector) CreateMessage(
 	flags imap.FlagSet,
 	_ time.Time,
 ) (imap.Message, []byte, error) {
+	if err := conn.checkWritable(ctx); err != nil {
+		return imap.Message{}, nil, err
+	}
+
 	defer conn.goPollAPIEvents(false)
 
 	if mailboxID == proton.AllMailLabel {
@@ -282,7 +328,7 @@ func (conn *imapConnector) CreateMessage(
 	}
 
 	// Compute the hash of the message (to match it against SMTP messages).
-	hash, err := getMessageHash(literal)
+	hash, err := getMessageHash(bytes.NewReader(literal))
 	if err != nil {
 		return imap.Message{}, nil, err
 	}
@@ -396,6 +442,10 @@ func (conn *imapConnector) GetMessageLiteral(ctx context.Context, id imap.Messag
 
 // AddMessagesToMailbox labels the given messages with the given label ID.
 func (conn *imapConnector) AddMessagesToMailbox(ctx context.Context, messageIDs []imap.MessageID, mailboxID imap.MailboxID) error {
+	if err := conn.checkWritable(ctx); err != nil {
+		return err
+	}
+
 	defer conn.goPollAPIEvents(false)
 
 	if isAllMailOrScheduled(mailboxID) {
@@ -407,8 +457,17 @@ func (conn *imapConnector) AddMessagesToMailbox(ctx context.Context, messageIDs
 
 // RemoveMessagesFromMailbox unlabels the given messages with the given label ID.
 func (conn *imapConnector) RemoveMessagesFromMailbox(ctx context.Context, messageIDs []imap.MessageID, mailboxID imap.MailboxID) error {
+	if err := conn.checkWritable(ctx); err != nil {
+		return err
+	}
+
 	defer conn.goPollAPIEvents(false)
 
+	// Removing a message from the scheduled messages cancels sending it; it becomes a draft again.
+	if mailboxID == proton.AllScheduledLabel {
+		return conn.cancelScheduledSend(ctx, messageIDs)
+	}
+
 	if isAllMailOrScheduled(mailboxID) {
 		return connector.ErrOperationNotAllowed
 	}
@@ -488,8 +547,27 @@ func (conn *imapConnector) RemoveMessagesFromMailbox(ctx context.Context, messag
 
 // MoveMessages removes the given messages from one label and adds them to the other label.
 func (conn *imapConnector) MoveMessages(ctx context.Context, messageIDs []imap.MessageID, labelFromID imap.MailboxID, labelToID imap.MailboxID) (bool, error) {
+	if err := conn.checkWritable(ctx); err != nil {
+		return false, err
+	}
+
 	defer conn.goPollAPIEvents(false)
 
+	// Moving a message out of the scheduled messages cancels sending it; the resulting draft is then moved.
+	if labelFromID == proton.AllScheduledLabel && !isAllMailOrScheduled(labelToID) {
+		if err := conn.cancelScheduledSend(ctx, messageIDs); err != nil {
+			return false, err
+		}
+
+		if labelToID != proton.DraftsLabel {
+			if err := conn.client.LabelMessages(ctx, mapTo[imap.MessageID, string](messageIDs), string(labelToID)); err != nil {
+				return false, fmt.Errorf("labeling messages: %w", err)
+			}
+		}
+
+		return true, nil
+	}
+
 	if (labelFromID == proton.InboxLabel && labelToID == proton.SentLabel) ||
 		(labelFromID == proton.SentLabel && labelToID == proton.InboxLabel) ||
 		isAllMailOrScheduled(labelFromID) ||
@@ -527,8 +605,28 @@ func (conn *imapConnector) MoveMessages(ctx context.Context, messageIDs []imap.M
 	return shouldExpungeOldLocation, nil
 }
 
+// cancelScheduledSend cancels sending the given scheduled messages.
+func (conn *imapConnector) cancelScheduledSend(ctx context.Context, messageIDs []imap.MessageID) error {
+	for _, messageID := range messageIDs {
+		if err := cancelScheduledSend(ctx, conn.client, string(messageID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarkMessagesSeen sets the seen value of the given messages.
+// Read-only sessions may mark messages as seen: fetching the body of a message implicitly does so.
 func (conn *imapConnector) MarkMessagesSeen(ctx context.Context, messageIDs []imap.MessageID, seen bool) error {
+	if !seen {
+		if err := conn.checkWritable(ctx); err != nil {
+			return err
+		}
+	} else if _, ok := conn.getIMAPSessionScope(ctx); !ok {
+		return fmt.Errorf("the session is unknown: %w", connector.ErrOperationNotAllowed)
+	}
+
 	defer conn.goPollAPIEvents(false)
 
 	if seen {
@@ -540,6 +638,10 @@ func (conn *imapConnector) MarkMessagesSeen(ctx context.Context, messageIDs []im
 
 // MarkMessagesFlagged sets the flagged value of the given messages.
 func (conn *imapConnector) MarkMessagesFlagged(ctx context.Context, messageIDs []imap.MessageID, flagged bool) error {
+	if err := conn.checkWritable(ctx); err != nil {
+		return err
+	}
+
 	defer conn.goPollAPIEvents(false)
 
 	if flagged {
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ProtonMail/gluon/connector"
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
+
+// IMAPAuthChecker decides whether IMAP sessions may attempt to authenticate,
+// e.g. to throttle repeated failed attempts or to require client certificates.
+// Failed attempts are reported by gluon once all connectors have refused the credentials, so only the rest is done here.
+type IMAPAuthChecker interface {
+	// AllowIMAPAuth returns whether the given IMAP session may attempt to authenticate as the given user with the given username.
+	AllowIMAPAuth(sessionID int, userID, username string) bool
+
+	// IMAPAuthSucceeded records that the given IMAP session has authenticated with the given username.
+	IMAPAuthSucceeded(sessionID int, username string)
+}
+
+// AuthorizeIMAPSCRAM authorizes the given IMAP session, which has proved with SCRAM that it knows the password
+// of the app password with the given ID, or the bridge password if empty, to log in with the given address.
+// It returns the ID of the gluon user the session logs in as. As in Authorize, credentials restricted to some addresses
+// can't be used in combined mode.
+func (user *User) AuthorizeIMAPSCRAM(ctx context.Context, sessionID int, email, appPassID string) (string, error) {
+	addrID, scope, err := user.CheckSCRAMAuth(email, appPassID)
+	if err != nil {
+		return "", err
+	}
+
+	if scope.IMAP == vault.IMAPDenied {
+		return "", ErrIMAPNotAllowed
+	}
+
+	if user.vault.AddressMode() == vault.CombinedMode {
+		if len(scope.Addresses) > 0 {
+			return "", ErrIMAPNotAllowed
+		}
+
+		if addrID, err = safe.RLockRetErr(func() (string, error) {
+			primAddr, err := getAddrIdx(user.apiAddrs, 0)
+			if err != nil {
+				return "", fmt.Errorf("failed to get primary address: %w", err)
+			}
+
+			return primAddr.ID, nil
+		}, user.apiAddrsLock); err != nil {
+			return "", err
+		}
+	}
+
+	gluonID, ok := user.GetGluonID(addrID)
+	if !ok {
+		return "", fmt.Errorf("no IMAP user for address %v", addrID)
+	}
+
+	user.setIMAPSessionScope(sessionID, scope)
+
+	user.authChecker.IMAPAuthSucceeded(sessionID, email)
+
+	user.SendConfigStatusSuccess(ctx)
+
+	return gluonID, nil
+}
+
+// RemoveIMAPSession forgets the scope of the credentials used by the given IMAP session, once it has ended.
+func (user *User) RemoveIMAPSession(sessionID int) {
+	safe.Lock(func() {
+		delete(user.imapScopes, sessionID)
+	}, user.imapScopesLock)
+}
+
+// setIMAPSessionScope records the scope of the credentials used by the given IMAP session.
+func (user *User) setIMAPSessionScope(sessionID int, scope vault.AppPasswordScope) {
+	safe.Lock(func() {
+		user.imapScopes[sessionID] = scope
+	}, user.imapScopesLock)
+}
+
+// getIMAPSessionScope returns the scope of the credentials used by the IMAP session of the given context.
+// It returns false if the session can't be identified or hasn't authenticated with this user's credentials.
+func (user *User) getIMAPSessionScope(ctx context.Context) (vault.AppPasswordScope, bool) {
+	sessionID, ok := imap.GetSessionIDFromContext(ctx)
+	if !ok {
+		return vault.AppPasswordScope{}, false
+	}
+
+	var scope vault.AppPasswordScope
+
+	safe.RLock(func() {
+		scope, ok = user.imapScopes[sessionID]
+	}, user.imapScopesLock)
+
+	return scope, ok
+}
+
+// checkWritable returns an error if the IMAP session of the given context may not modify the mailbox.
+// Sessions whose scope is unknown are treated as read-only.
+func (conn *imapConnector) checkWritable(ctx context.Context) error {
+	if scope, ok := conn.getIMAPSessionScope(ctx); !ok || scope.IMAP == vault.IMAPReadOnly {
+		return fmt.Errorf("the session is read-only: %w", connector.ErrOperationNotAllowed)
+	}
+
+	return nil
+}
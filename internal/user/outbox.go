@@ -0,0 +1,294 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/google/uuid"
+)
+
+// GetOutbox returns the messages waiting in the user's outbox, oldest first.
+func (user *User) GetOutbox() ([]vault.OutboxMessage, error) {
+	return user.vault.GetOutbox()
+}
+
+// CancelOutboxMessage removes a message from the user's outbox so that it is never sent.
+// The draft created by a previous attempt to send it, if any, is kept in the user's drafts.
+func (user *User) CancelOutboxMessage(messageID string) error {
+	return safe.LockRet(func() error {
+		if err := user.vault.RemoveOutboxMessage(messageID); err != nil {
+			return err
+		}
+
+		user.log.WithField("messageID", messageID).Info("Canceled queued message")
+
+		return nil
+	}, user.outboxLock)
+}
+
+// queueMail stores a message which could not be sent in the user's outbox.
+// It will be sent by the outbox worker once the API is reachable again.
+func (user *User) queueMail(authID string, from string, to []string, dsn DSN, literal []byte, cause error) error {
+	message := vault.OutboxMessage{
+		ID:            uuid.NewString(),
+		AuthID:        authID,
+		From:          from,
+		To:            to,
+		DSN:           make(map[string]vault.OutboxDSN, len(dsn.Recipients)),
+		DSNReturnFull: dsn.ReturnFull,
+		DSNEnvelopeID: dsn.EnvelopeID,
+		Literal:       literal,
+		QueuedAt:      time.Now(),
+		Attempts:      1,
+		LastError:     cause.Error(),
+	}
+
+	for recipient, params := range dsn.Recipients {
+		message.DSN[recipient] = vault.OutboxDSN{
+			Notify:            uint8(params.Notify),
+			OriginalRecipient: params.OriginalRecipient,
+		}
+	}
+
+	if err := setOutboxDraft(&message, cause); err != nil {
+		return fmt.Errorf("failed to queue message: %w", err)
+	}
+
+	if err := user.vault.PutOutboxMessage(message); err != nil {
+		return fmt.Errorf("failed to queue message: %w", err)
+	}
+
+	user.log.WithField("messageID", message.ID).WithError(cause).Warn("Failed to send message, it has been queued")
+
+	user.eventCh.Enqueue(events.OutboxMessageQueued{
+		UserID:    user.ID(),
+		MessageID: message.ID,
+		Error:     cause,
+	})
+
+	user.goDrainOutbox()
+
+	return nil
+}
+
+// drainOutbox sends the messages in the user's outbox, oldest first.
+// Messages which fail to send because the API is unreachable are retried with an exponential backoff;
+// messages which fail for any other reason are dropped, and the failure is reported to the sender.
+func (user *User) drainOutbox(ctx context.Context) {
+	cooldown := expCooldown{}
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		messages, err := user.vault.GetOutbox()
+		if err != nil {
+			user.log.WithError(err).Error("Failed to read outbox")
+			return
+		} else if len(messages) == 0 {
+			return
+		}
+
+		if err := user.sendOutboxMessage(messages[0].ID); err != nil {
+			wait := cooldown.GetNextWaitTime()
+			user.log.WithField("retry-after", wait).WithError(err).Warn("Failed to send queued message, will retry later")
+			sleepCtx(ctx, wait)
+		} else {
+			cooldown = expCooldown{}
+		}
+	}
+}
+
+// sendOutboxMessage attempts to send the queued message with the given ID.
+// It returns an error only if the message should be retried later.
+func (user *User) sendOutboxMessage(messageID string) error {
+	return safe.LockRet(func() error {
+		// The message may have been canceled in the meantime.
+		message, err := user.vault.GetOutboxMessage(messageID)
+		if errors.Is(err, vault.ErrNoSuchOutboxMessage) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		logEntry := user.log.WithField("messageID", message.ID)
+
+		if err := user.resendOutboxMessage(message); isTransientSendError(err) {
+			message.Attempts++
+			message.LastError = err.Error()
+
+			if err := setOutboxDraft(&message, err); err != nil {
+				logEntry.WithError(err).Error("Failed to record draft of queued message")
+			}
+
+			if err := user.vault.PutOutboxMessage(message); err != nil {
+				logEntry.WithError(err).Error("Failed to update queued message")
+			}
+
+			return err
+		} else if err != nil {
+			logEntry.WithError(err).Error("Failed to send queued message, dropping it")
+
+			if !errors.Is(err, ErrInvalidReturnPath) {
+				user.reportDeliveryFailure(message.From, message.To, getOutboxDSN(message), memLiteral(message.Literal), err)
+			}
+
+			user.eventCh.Enqueue(events.OutboxMessageFailed{
+				UserID:    user.ID(),
+				MessageID: message.ID,
+				Error:     err,
+			})
+		} else {
+			logEntry.Info("Queued message sent")
+
+			user.eventCh.Enqueue(events.OutboxMessageSent{
+				UserID:    user.ID(),
+				MessageID: message.ID,
+			})
+
+			if user.vault.SyncStatus().IsComplete() {
+				user.goPollAPIEvents(false)
+			}
+		}
+
+		if err := user.vault.RemoveOutboxMessage(message.ID); err != nil {
+			logEntry.WithError(err).Error("Failed to remove message from outbox")
+		}
+
+		return nil
+	}, user.outboxLock)
+}
+
+// resendOutboxMessage makes another attempt to send the given queued message.
+// If the previous attempt failed when sending its draft, the same draft is sent again.
+// Otherwise, the draft it left, if any, is removed and the message is sent from the start.
+func (user *User) resendOutboxMessage(message vault.OutboxMessage) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if message.SendReq != nil {
+		var req proton.SendDraftReq
+
+		if err := json.Unmarshal(message.SendReq, &req); err != nil {
+			return fmt.Errorf("failed to decode send request: %w", err)
+		}
+
+		if _, err := user.client.SendDraft(ctx, message.DraftID, req); err != nil {
+			return &draftSendError{
+				draftID:       message.DraftID,
+				attachmentIDs: message.AttachmentIDs,
+				req:           &req,
+				err:           fmt.Errorf("failed to send draft: %w", err),
+			}
+		}
+
+		return nil
+	}
+
+	if message.DraftID != "" {
+		if err := user.client.DeleteMessage(ctx, message.DraftID); isTransientSendError(err) {
+			return &draftSendError{
+				draftID:       message.DraftID,
+				attachmentIDs: message.AttachmentIDs,
+				err:           fmt.Errorf("failed to remove draft: %w", err),
+			}
+		} else if err != nil {
+			user.log.WithField("draftID", message.DraftID).WithError(err).Warn("Failed to remove draft of queued message")
+		}
+	}
+
+	// The sender was checked against the scope of the credentials used when the message was queued.
+	return user.sendMail(message.AuthID, vault.AppPasswordScope{}, message.From, message.To, memLiteral(message.Literal))
+}
+
+// setOutboxDraft records in the given queued message the draft left by the attempt to send it which failed with err.
+func setOutboxDraft(message *vault.OutboxMessage, err error) error {
+	message.DraftID, message.AttachmentIDs, message.SendReq = "", nil, nil
+
+	sendErr := new(draftSendError)
+	if !errors.As(err, &sendErr) {
+		return nil
+	}
+
+	message.DraftID = sendErr.draftID
+	message.AttachmentIDs = sendErr.attachmentIDs
+
+	if sendErr.req != nil {
+		b, err := json.Marshal(sendErr.req)
+		if err != nil {
+			return fmt.Errorf("failed to encode send request: %w", err)
+		}
+
+		message.SendReq = b
+	}
+
+	return nil
+}
+
+// getOutboxDSN returns the DSN parameters stored with the given queued message.
+func getOutboxDSN(message vault.OutboxMessage) DSN {
+	dsn := DSN{
+		ReturnFull: message.DSNReturnFull,
+		EnvelopeID: message.DSNEnvelopeID,
+		Recipients: make(map[string]DSNRecipient, len(message.DSN)),
+	}
+
+	for recipient, params := range message.DSN {
+		dsn.Recipients[recipient] = DSNRecipient{
+			Notify:            DSNNotify(params.Notify),
+			OriginalRecipient: params.OriginalRecipient,
+		}
+	}
+
+	return dsn
+}
+
+// isTransientSendError returns whether sending failed because the API is unreachable or temporarily unavailable,
+// in which case sending the message again later may succeed.
+// Once the request to send the draft was made, the API may have accepted it even though no response was received;
+// the error is then transient only if the request clearly wasn't accepted, so that the message is never sent twice.
+func isTransientSendError(err error) bool {
+	if sendErr := new(draftSendError); errors.As(err, &sendErr) && sendErr.req != nil {
+		if apiErr := new(proton.APIError); errors.As(err, &apiErr) {
+			return apiErr.Status == http.StatusTooManyRequests
+		}
+
+		return proton.IsDialError(err)
+	}
+
+	if netErr := new(proton.NetError); errors.As(err, &netErr) {
+		return true
+	}
+
+	if apiErr := new(proton.APIError); errors.As(err, &apiErr) {
+		return apiErr.Status == http.StatusTooManyRequests || apiErr.Status >= http.StatusInternalServerError
+	}
+
+	return false
+}
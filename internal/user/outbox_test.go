@@ -0,0 +1,197 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_SendMail_SendTimeout(t *testing.T) {
+	// The first attempt to send the draft reaches the API, but no response is received.
+	transport := &sendTransport{
+		failSend: func(n int) (bool, error) {
+			if n == 1 {
+				return true, &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}
+			}
+
+			return true, nil
+		},
+	}
+
+	withSendTransport(t, transport, func(ctx context.Context, s *server.Server, m *proton.Manager) {
+		withAccount(t, s, "username", "password", []string{}, func(string, []string) {
+			withUser(t, ctx, s, m, "username", "password", func(user *User) {
+				from := "username@" + s.GetDomain()
+
+				// The message may have been sent, so it is neither queued nor sent again.
+				require.Error(t, user.SendMail(user.ID(), vault.AppPasswordScope{}, from, []string{"recipient@example.com"}, DSN{}, newTestMessage(from)))
+				outbox, err := user.GetOutbox()
+				require.NoError(t, err)
+				require.Empty(t, outbox)
+
+				require.Equal(t, 1, transport.getDrafts())
+				require.Equal(t, 1, transport.getSends())
+			})
+		})
+	})
+}
+
+func TestUser_SendMail_SendRefused(t *testing.T) {
+	// The API can't be reached when sending the draft, on the first attempt and the three retries made by the client.
+	transport := &sendTransport{
+		failSend: func(n int) (bool, error) {
+			if n <= 4 {
+				return false, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+			}
+
+			return true, nil
+		},
+	}
+
+	withSendTransport(t, transport, func(ctx context.Context, s *server.Server, m *proton.Manager) {
+		withAccount(t, s, "username", "password", []string{}, func(string, []string) {
+			withUser(t, ctx, s, m, "username", "password", func(user *User) {
+				from := "username@" + s.GetDomain()
+
+				// The message clearly wasn't sent, so it is queued.
+				require.NoError(t, user.SendMail(user.ID(), vault.AppPasswordScope{}, from, []string{"recipient@example.com"}, DSN{}, newTestMessage(from)))
+
+				// The outbox sends the same draft again rather than creating another one.
+				require.Eventually(t, func() bool {
+					outbox, err := user.GetOutbox()
+					return err == nil && len(outbox) == 0
+				}, 10*time.Second, 100*time.Millisecond)
+
+				require.Equal(t, 1, transport.getDrafts())
+				require.Equal(t, 5, transport.getSends())
+			})
+		})
+	})
+}
+
+// sendTransport is an HTTP transport which counts the drafts created and sent through it.
+// failSend is called for each attempt to send a draft; it returns whether to forward the request to the API,
+// and the error to return instead of the API's response, if any.
+type sendTransport struct {
+	failSend func(n int) (bool, error)
+
+	drafts, sends int
+	lock          sync.Mutex
+}
+
+func (t *sendTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost {
+		return proton.InsecureTransport().RoundTrip(req)
+	}
+
+	switch id := strings.TrimPrefix(req.URL.Path, "/mail/v4/messages"); {
+	case id == "":
+		t.lock.Lock()
+		t.drafts++
+		t.lock.Unlock()
+
+	case strings.HasPrefix(id, "/") && strings.Count(id, "/") == 1 && id != "/import":
+		t.lock.Lock()
+		t.sends++
+		n := t.sends
+		t.lock.Unlock()
+
+		forward, err := t.failSend(n)
+
+		if forward {
+			res, resErr := proton.InsecureTransport().RoundTrip(req)
+			if err == nil {
+				return res, resErr
+			} else if resErr == nil {
+				_ = res.Body.Close()
+			}
+		}
+
+		return nil, err
+	}
+
+	return proton.InsecureTransport().RoundTrip(req)
+}
+
+func (t *sendTransport) getDrafts() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.drafts
+}
+
+func (t *sendTransport) getSends() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.sends
+}
+
+func newTestMessage(from string) *strings.Reader {
+	return strings.NewReader("From: " + from + "\r\nTo: recipient@example.com\r\nSubject: Test\r\n\r\nHello\r\n")
+}
+
+func withSendTransport(_ testing.TB, transport *sendTransport, fn func(context.Context, *server.Server, *proton.Manager)) {
+	server := server.New()
+	defer server.Close()
+
+	fn(context.Background(), server, proton.New(
+		proton.WithHostURL(server.GetHostURL()),
+		proton.WithTransport(transport),
+	))
+}
+
+func TestIsTransientSendError(t *testing.T) {
+	require.False(t, isTransientSendError(nil))
+	require.False(t, isTransientSendError(errors.New("some error")))
+	require.False(t, isTransientSendError(ErrInvalidReturnPath))
+
+	require.True(t, isTransientSendError(&proton.NetError{Cause: errors.New("connection refused")}))
+	require.True(t, isTransientSendError(fmt.Errorf("failed to send: %w", &proton.NetError{})))
+
+	require.True(t, isTransientSendError(&proton.APIError{Status: http.StatusTooManyRequests}))
+	require.True(t, isTransientSendError(&proton.APIError{Status: http.StatusServiceUnavailable}))
+	require.False(t, isTransientSendError(&proton.APIError{Status: http.StatusUnprocessableEntity}))
+
+	// Before the draft is sent, the same errors are transient.
+	require.True(t, isTransientSendError(&draftSendError{err: &proton.NetError{Cause: errors.New("timeout")}}))
+	require.True(t, isTransientSendError(&draftSendError{err: &proton.APIError{Status: http.StatusServiceUnavailable}}))
+
+	// Once the draft is sent, only errors showing it wasn't accepted are.
+	sent := &proton.SendDraftReq{}
+
+	require.True(t, isTransientSendError(&draftSendError{req: sent, err: &proton.NetError{Cause: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}}))
+	require.True(t, isTransientSendError(&draftSendError{req: sent, err: &proton.APIError{Status: http.StatusTooManyRequests}}))
+	require.False(t, isTransientSendError(&draftSendError{req: sent, err: &proton.NetError{Cause: &net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}}}))
+	require.False(t, isTransientSendError(&draftSendError{req: sent, err: &proton.APIError{Status: http.StatusServiceUnavailable}}))
+}
@@ -18,15 +18,23 @@
 package user
 
 import (
+	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/bradenaw/juniper/xslices"
+	"github.com/emersion/go-message/textproto"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -249,8 +257,113 @@ func (h *sendRecorder) getWaitCh(hash string) (<-chan struct{}, bool) {
 // - the Content-Type header of each (leaf) part,
 // - the Content-Disposition header of each (leaf) part,
 // - the (decoded) body of each part.
-func getMessageHash(b []byte) (string, error) {
-	return rfc822.GetMessageHash(b)
+// getMessageHash returns the hash of the message read from r, reading it once without holding it in memory.
+// This takes into account:
+// - the Subject, From, To, Cc, Reply-To and In-Reply-To headers,
+// - the Content-Type (without boundary) and Content-Disposition headers of each leaf part,
+// - the body of each leaf part, without carriage returns and surrounding whitespace.
+func getMessageHash(r io.Reader) (string, error) {
+	br := bufio.NewReader(r)
+
+	header, err := textproto.ReadHeader(br)
+	if err != nil {
+		return "", fmt.Errorf("failed to read header: %w", err)
+	}
+
+	h := sha256.New()
+
+	for _, key := range []string{"Subject", "From", "To", "Cc", "Reply-To", "In-Reply-To"} {
+		h.Write([]byte(header.Get(key)))
+	}
+
+	if err := hashMessagePart(h, header, br); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashMessagePart adds the part with the given header and body to the hash, descending into multipart bodies.
+func hashMessagePart(h hash.Hash, header textproto.Header, body io.Reader) error {
+	mimeType, params, err := rfc822.ParseMIMEType(header.Get("Content-Type"))
+	if err != nil {
+		logrus.WithError(err).Warn("Message contains invalid MIME type")
+	} else if mimeType.IsMultiPart() {
+		mr := textproto.NewMultipartReader(body, params["boundary"])
+
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("failed to read part: %w", err)
+			}
+
+			if err := hashMessagePart(h, part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err == nil {
+		h.Write([]byte(mimeType))
+
+		keys := maps.Keys(params)
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			if !strings.EqualFold(key, "boundary") {
+				h.Write([]byte(key + params[key]))
+			}
+		}
+	}
+
+	h.Write([]byte(header.Get("Content-Disposition")))
+
+	if _, err := io.Copy(&trimSpaceWriter{w: h}, body); err != nil {
+		return fmt.Errorf("failed to read body: %w", err)
+	}
+
+	return nil
+}
+
+// trimSpaceWriter writes what is written to it without carriage returns and leading and trailing whitespace.
+type trimSpaceWriter struct {
+	w io.Writer
+
+	started bool
+	pending []byte
+}
+
+func (tw *trimSpaceWriter) Write(p []byte) (int, error) {
+	out := make([]byte, 0, len(p))
+
+	for _, c := range p {
+		switch {
+		case c == '\r':
+			continue
+
+		case c == ' ' || c == '\t' || c == '\n' || c == '\v' || c == '\f':
+			// Whitespace is only written once something follows it.
+			if tw.started {
+				tw.pending = append(tw.pending, c)
+			}
+
+		default:
+			out = append(out, tw.pending...)
+			out = append(out, c)
+
+			tw.started = true
+			tw.pending = tw.pending[:0]
+		}
+	}
+
+	if _, err := tw.w.Write(out); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
 }
 
 func matchToList(a, b []string) bool {
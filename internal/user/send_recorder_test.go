@@ -18,7 +18,9 @@
 package user
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -385,6 +387,18 @@ func TestGetMessageHash(t *testing.T) {
 			lit2:      []byte(literal2),
 			wantEqual: false,
 		},
+		{
+			name:      "surrounding whitespace and line endings should still match",
+			lit1:      []byte("To: a@b.c\r\n\r\n  Hello\r\nworld\r\n\r\n"),
+			lit2:      []byte("To: a@b.c\n\nHello\nworld"),
+			wantEqual: true,
+		},
+		{
+			name:      "different inner whitespace",
+			lit1:      []byte("To: a@b.c\r\n\r\nHello world"),
+			lit2:      []byte("To: a@b.c\r\n\r\nHello  world"),
+			wantEqual: false,
+		},
 		{
 			name:      "different date and message ID should still match",
 			lit1:      []byte("To: a@b.c\r\nDate: Fri, 13 Aug 1982\r\nMessage-Id: 1@b.c\r\n\r\nHello"),
@@ -395,10 +409,10 @@ func TestGetMessageHash(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			hash1, err := getMessageHash(tt.lit1)
+			hash1, err := getMessageHash(bytes.NewReader(tt.lit1))
 			require.NoError(t, err)
 
-			hash2, err := getMessageHash(tt.lit2)
+			hash2, err := getMessageHash(bytes.NewReader(tt.lit2))
 			require.NoError(t, err)
 
 			if tt.wantEqual {
@@ -410,8 +424,43 @@ func TestGetMessageHash(t *testing.T) {
 	}
 }
 
+func TestGetMessageHash_Spooled(t *testing.T) {
+	b := []byte(literal1)
+
+	spooled, err := newSpooledLiteral(bytes.NewReader(b), -1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, spooled.Close()) }()
+
+	r, err := spooled.NewReader()
+	require.NoError(t, err)
+
+	// The message is hashed the same whether it is read from memory or from the spool.
+	spooledHash, err := getMessageHash(r)
+	require.NoError(t, err)
+
+	memHash, err := getMessageHash(bytes.NewReader(b))
+	require.NoError(t, err)
+
+	require.Equal(t, memHash, spooledHash)
+}
+
+func TestTrimSpaceWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	w := &trimSpaceWriter{w: buf}
+
+	// Whitespace is trimmed even when it is split across writes.
+	for _, chunk := range []string{"\r\n ", " Hello \r", "\n", "world", " \r\n", "\r\n"} {
+		n, err := w.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+
+	require.Equal(t, "Hello \nworld", buf.String())
+}
+
 func testTryInsert(h *sendRecorder, literal string, deadline time.Time, toList ...string) (string, bool, error) { //nolint:unparam
-	hash, err := getMessageHash([]byte(literal))
+	hash, err := getMessageHash(strings.NewReader(literal))
 	if err != nil {
 		return "", false, err
 	}
@@ -425,7 +474,7 @@ func testTryInsert(h *sendRecorder, literal string, deadline time.Time, toList .
 }
 
 func testHasEntry(h *sendRecorder, literal string, deadline time.Time) (string, bool, error) { //nolint:unparam
-	hash, err := getMessageHash([]byte(literal))
+	hash, err := getMessageHash(strings.NewReader(literal))
 	if err != nil {
 		return "", false, err
 	}
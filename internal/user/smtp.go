@@ -18,11 +18,9 @@
 package user
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
-	"io"
 	"mime"
 	"net/mail"
 	"runtime"
@@ -48,7 +46,7 @@ import (
 )
 
 // sendMail sends an email from the given address to the given recipients.
-func (user *User) sendMail(authID string, from string, to []string, r io.Reader) error {
+func (user *User) sendMail(authID string, scope vault.AppPasswordScope, from string, to []string, literal literalSource) error {
 	defer async.HandlePanic(user.panicHandler)
 
 	return safe.RLockRet(func() error {
@@ -59,23 +57,16 @@ func (user *User) sendMail(authID string, from string, to []string, r io.Reader)
 			return ErrInvalidReturnPath
 		}
 
+		if !scope.HasAddress(from) {
+			return ErrSenderNotAllowed
+		}
+
 		emails := xslices.Map(maps.Values(user.apiAddrs), func(addr proton.Address) string {
 			return addr.Email
 		})
 
-		// Read the message to send.
-		b, err := io.ReadAll(r)
-		if err != nil {
-			return fmt.Errorf("failed to read message: %w", err)
-		}
-
-		// If running a QA build, dump to disk.
-		if err := debugDumpToDisk(b); err != nil {
-			user.log.WithError(err).Warn("Failed to dump message to disk")
-		}
-
 		// Compute the hash of the message (to match it against SMTP messages).
-		hash, err := getMessageHash(b)
+		hash, err := user.getLiteralHash(literal)
 		if err != nil {
 			return err
 		}
@@ -91,8 +82,13 @@ func (user *User) sendMail(authID string, from string, to []string, r io.Reader)
 		// If we fail to send this message, we should remove the hash from the send recorder.
 		defer user.sendHash.removeOnFail(hash, to)
 
-		// Create a new message parser from the reader.
-		parser, err := parser.New(bytes.NewReader(b))
+		// Create a new message parser from the literal, reading it again rather than keeping it in memory.
+		r, err := literal.NewReader()
+		if err != nil {
+			return fmt.Errorf("failed to read message: %w", err)
+		}
+
+		parser, err := parser.New(r)
 		if err != nil {
 			return fmt.Errorf("failed to create parser: %w", err)
 		}
@@ -102,6 +98,16 @@ func (user *User) sendMail(authID string, from string, to []string, r io.Reader)
 			from = sender
 		}
 
+		if !scope.HasAddress(from) {
+			return ErrSenderNotAllowed
+		}
+
+		// If the client asked for the message to be sent later, schedule it.
+		deliveryTime, err := getDeliveryTime(parser)
+		if err != nil {
+			return err
+		}
+
 		// Load the user's mail settings.
 		settings, err := user.client.GetMailSettings(ctx)
 		if err != nil {
@@ -156,6 +162,7 @@ func (user *User) sendMail(authID string, from string, to []string, r io.Reader)
 				userKR, addrKR,
 				emails, from, to,
 				message,
+				deliveryTime,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to send message: %w", err)
@@ -169,7 +176,23 @@ func (user *User) sendMail(authID string, from string, to []string, r io.Reader)
 	}, user.apiUserLock, user.apiAddrsLock, user.eventLock)
 }
 
-// sendWithKey sends the message with the given address key.
+// getLiteralHash returns the hash of the given literal, reading it from its source rather than into memory.
+func (user *User) getLiteralHash(literal literalSource) (string, error) {
+	// If running a QA build, dump to disk.
+	if err := debugDumpToDisk(literal); err != nil {
+		user.log.WithError(err).Warn("Failed to dump message to disk")
+	}
+
+	r, err := literal.NewReader()
+	if err != nil {
+		return "", fmt.Errorf("failed to read message: %w", err)
+	}
+
+	return getMessageHash(r)
+}
+
+// sendWithKey sends the message with the given address key, at the given time if it isn't zero.
+// It also sets the expiration and password protection the client asked for in the message.
 func (user *User) sendWithKey(
 	ctx context.Context,
 	client *proton.Client,
@@ -182,6 +205,7 @@ func (user *User) sendWithKey(
 	from string,
 	to []string,
 	message message.Message,
+	deliveryTime time.Time,
 ) (proton.Message, error) {
 	references := message.References
 	if message.InReplyTo != "" {
@@ -231,27 +255,76 @@ func (user *User) sendWithKey(
 
 	attKeys, err := user.createAttachments(ctx, client, addrKR, draft.ID, message.Attachments)
 	if err != nil {
-		return proton.Message{}, fmt.Errorf("failed to create attachments: %w", err)
+		return proton.Message{}, &draftSendError{draftID: draft.ID, err: fmt.Errorf("failed to create attachments: %w", err)}
 	}
 
 	recipients, err := user.getRecipients(ctx, client, userKR, settings, draft)
 	if err != nil {
-		return proton.Message{}, fmt.Errorf("failed to get recipients: %w", err)
+		return proton.Message{}, &draftSendError{draftID: draft.ID, err: fmt.Errorf("failed to get recipients: %w", err)}
 	}
 
-	req, err := createSendReq(addrKR, message.MIMEBody, message.RichBody, message.PlainBody, recipients, attKeys)
+	// If the message is password-protected, the recipients who would get it in the clear get it protected instead.
+	var eo *eoPassword
+
+	if message.Password != "" && len(recipients.scheme(proton.ClearScheme, proton.ClearMIMEScheme)) > 0 {
+		modulus, err := user.modulusProvider.AuthModulus(ctx)
+		if err != nil {
+			return proton.Message{}, &draftSendError{draftID: draft.ID, err: fmt.Errorf("failed to get modulus: %w", err)}
+		}
+
+		if eo, err = newEOPassword(modulus, message.Password, message.PasswordHint); err != nil {
+			return proton.Message{}, &draftSendError{draftID: draft.ID, err: fmt.Errorf("failed to protect message: %w", err)}
+		}
+
+		recipients.protect(message.MIMEType)
+	}
+
+	req, err := createSendReq(addrKR, message.MIMEBody, message.RichBody, message.PlainBody, recipients, attKeys, eo)
 	if err != nil {
-		return proton.Message{}, fmt.Errorf("failed to create packages: %w", err)
+		return proton.Message{}, &draftSendError{draftID: draft.ID, err: fmt.Errorf("failed to create packages: %w", err)}
+	}
+
+	if !deliveryTime.IsZero() {
+		req.DeliveryTime = deliveryTime.Unix()
+	}
+
+	if message.ExpiresIn > 0 {
+		req.ExpiresIn = int64(message.ExpiresIn.Seconds())
 	}
 
 	res, err := client.SendDraft(ctx, draft.ID, req)
 	if err != nil {
-		return proton.Message{}, fmt.Errorf("failed to send draft: %w", err)
+		return proton.Message{}, &draftSendError{
+			draftID:       draft.ID,
+			attachmentIDs: maps.Keys(attKeys),
+			req:           &req,
+			err:           fmt.Errorf("failed to send draft: %w", err),
+		}
 	}
 
 	return res, nil
 }
 
+// draftSendError is returned when sending a message failed after its draft was created.
+// It holds what is needed to send the same draft again, or to remove it, rather than creating another one.
+type draftSendError struct {
+	draftID       string
+	attachmentIDs []string
+
+	// req is the request made to send the draft; it is nil if sending failed before the request was made.
+	req *proton.SendDraftReq
+
+	err error
+}
+
+func (err *draftSendError) Error() string {
+	return err.err.Error()
+}
+
+func (err *draftSendError) Unwrap() error {
+	return err.err
+}
+
 func getParentID(
 	ctx context.Context,
 	client *proton.Client,
@@ -490,34 +563,62 @@ func (user *User) getRecipients(
 		return addr.Address
 	})
 
-	prefs, err := parallel.MapContext(ctx, runtime.NumCPU(), addresses, func(ctx context.Context, recipient string) (proton.SendPreferences, error) {
-		defer async.HandlePanic(user.panicHandler)
+	type recipientPrefs struct {
+		prefs proton.SendPreferences
+		err   error
+	}
 
-		pubKeys, recType, err := client.GetPublicKeys(ctx, recipient)
-		if err != nil {
-			return proton.SendPreferences{}, fmt.Errorf("failed to get public key for %v: %w", recipient, err)
-		}
+	// Errors are collected per recipient rather than aborting, so that they can all be reported to the sender.
+	prefs, err := parallel.MapContext(ctx, runtime.NumCPU(), addresses, func(ctx context.Context, recipient string) (recipientPrefs, error) {
+		defer async.HandlePanic(user.panicHandler)
 
-		contactSettings, err := getContactSettings(ctx, client, userKR, recipient)
-		if err != nil {
-			return proton.SendPreferences{}, fmt.Errorf("failed to get contact settings for %v: %w", recipient, err)
-		}
+		prefs, err := user.getSendPrefs(ctx, client, userKR, settings, draft.MIMEType, recipient)
 
-		return buildSendPrefs(contactSettings, settings, pubKeys, draft.MIMEType, recType == proton.RecipientTypeInternal)
+		return recipientPrefs{prefs: prefs, err: err}, nil
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get send preferences: %w", err)
 	}
 
 	recipients := make(recipients)
+	rcptErrs := make(recipientErrors)
 
 	for idx, pref := range prefs {
-		recipients[addresses[idx]] = pref
+		if pref.err != nil {
+			rcptErrs[addresses[idx]] = pref.err
+		} else {
+			recipients[addresses[idx]] = pref.prefs
+		}
+	}
+
+	if len(rcptErrs) > 0 {
+		return nil, rcptErrs
 	}
 
 	return recipients, nil
 }
 
+// getSendPrefs builds the send preferences of the given recipient, reusing its lookup from RCPT if there is one.
+func (user *User) getSendPrefs(
+	ctx context.Context,
+	client *proton.Client,
+	userKR *crypto.KeyRing,
+	settings proton.MailSettings,
+	mimeType rfc822.MIMEType,
+	recipient string,
+) (proton.SendPreferences, error) {
+	info, ok := user.recipientCache.take(recipient)
+	if !ok {
+		var err error
+
+		if info, err = lookupRecipient(ctx, client, userKR, recipient); err != nil {
+			return proton.SendPreferences{}, err
+		}
+	}
+
+	return buildSendPrefs(info.contactSettings, settings, info.pubKeys, mimeType, info.recType == proton.RecipientTypeInternal)
+}
+
 func getContactSettings(
 	ctx context.Context,
 	client *proton.Client,
@@ -21,12 +21,13 @@ package user
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
 )
 
-func debugDumpToDisk(b []byte) error {
+func debugDumpToDisk(literal literalSource) error {
 	if os.Getenv("BRIDGE_SMTP_DEBUG") == "" {
 		return nil
 	}
@@ -36,11 +37,22 @@ func debugDumpToDisk(b []byte) error {
 		return fmt.Errorf("failed to get user home dir: %w", err)
 	}
 
-	if err := os.WriteFile(filepath.Join(home, getFileName()), b, 0600); err != nil {
+	r, err := literal.NewReader()
+	if err != nil {
+		return fmt.Errorf("failed to read message: %w", err)
+	}
+
+	file, err := os.OpenFile(filepath.Join(home, getFileName()), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create message file: %w", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	if _, err := io.Copy(file, r); err != nil {
 		return fmt.Errorf("failed to write message file: %w", err)
 	}
 
-	return nil
+	return file.Close()
 }
 
 func getFileName() string {
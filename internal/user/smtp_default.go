@@ -19,6 +19,6 @@
 
 package user
 
-func debugDumpToDisk(_ []byte) error {
+func debugDumpToDisk(_ literalSource) error {
 	return nil
 }
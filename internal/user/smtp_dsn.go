@@ -0,0 +1,332 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
+	"github.com/bradenaw/juniper/stream"
+	"github.com/emersion/go-message/textproto"
+	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
+)
+
+// DSNNotify describes when a delivery status notification should be generated for a recipient (RFC 3461).
+type DSNNotify uint8
+
+const (
+	DSNNotifyFailure DSNNotify = 1 << iota
+	DSNNotifyDelay
+	DSNNotifySuccess
+	DSNNotifyNever
+)
+
+// DSN holds the delivery status notification parameters (RFC 3461) given for a message.
+type DSN struct {
+	// ReturnFull is whether the whole message should be returned in notifications (RET=FULL), rather than its header.
+	ReturnFull bool
+
+	// EnvelopeID is the ENVID parameter of the MAIL command, returned in notifications.
+	EnvelopeID string
+
+	// Recipients holds the parameters given with the RCPT command, keyed by address.
+	Recipients map[string]DSNRecipient
+}
+
+// DSNRecipient holds the delivery status notification parameters given for a recipient with the RCPT command.
+type DSNRecipient struct {
+	// Notify is when a notification should be generated. If zero, the default applies: only on failure.
+	Notify DSNNotify
+
+	// OriginalRecipient is the ORCPT parameter, e.g. "rfc822;user@example.com".
+	OriginalRecipient string
+}
+
+// wantsFailureReport returns whether a failure report should be generated for the recipient.
+func (rcpt DSNRecipient) wantsFailureReport() bool {
+	return rcpt.Notify == 0 || rcpt.Notify&DSNNotifyFailure != 0
+}
+
+// recipientErrors holds the errors which prevented sending to some of the recipients, keyed by address.
+type recipientErrors map[string]error
+
+func (errs recipientErrors) Error() string {
+	addresses := maps.Keys(errs)
+
+	sort.Strings(addresses)
+
+	msgs := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		msgs = append(msgs, fmt.Sprintf("%v: %v", address, errs[address]))
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (errs recipientErrors) Unwrap() []error {
+	return maps.Values(errs)
+}
+
+// deliveryFailure describes why a message could not be delivered to one of its recipients.
+type deliveryFailure struct {
+	recipient string
+	original  string
+	status    string
+	err       error
+}
+
+// getDeliveryFailures returns, for each recipient which asked for it, why the message could not be delivered.
+// Recipients for which no specific error is known failed because of the message as a whole.
+func getDeliveryFailures(to []string, dsn map[string]DSNRecipient, err error) []deliveryFailure {
+	var rcptErrs recipientErrors
+
+	if !errors.As(err, &rcptErrs) {
+		rcptErrs = nil
+	}
+
+	var failures []deliveryFailure
+
+	for _, recipient := range to {
+		if !dsn[recipient].wantsFailureReport() {
+			continue
+		}
+
+		failure := deliveryFailure{
+			recipient: recipient,
+			original:  dsn[recipient].OriginalRecipient,
+			status:    "5.0.0",
+			err:       err,
+		}
+
+		if rcptErr, ok := rcptErrs[recipient]; ok {
+			failure.err = rcptErr
+
+			// The API rejects key lookups for addresses which do not exist.
+			if apiErr := new(proton.APIError); errors.As(rcptErr, &apiErr) && apiErr.Status == http.StatusUnprocessableEntity {
+				failure.status = "5.1.1"
+			}
+		}
+
+		failures = append(failures, failure)
+	}
+
+	return failures
+}
+
+// reportDeliveryFailure adds a delivery status notification to the sender's inbox describing why the message
+// could not be delivered, unless all recipients asked not to be notified.
+func (user *User) reportDeliveryFailure(from string, to []string, dsn DSN, literal literalSource, err error) {
+	failures := getDeliveryFailures(to, dsn.Recipients, err)
+	if len(failures) == 0 {
+		return
+	}
+
+	original, readErr := readReturnedLiteral(literal, dsn.ReturnFull)
+	if readErr != nil {
+		user.log.WithError(readErr).Error("Failed to read message")
+		return
+	}
+
+	report, buildErr := buildDSN(from, time.Now(), dsn, original, failures)
+	if buildErr != nil {
+		user.log.WithError(buildErr).Error("Failed to build delivery status notification")
+		return
+	}
+
+	if err := user.importDSN(from, report); err != nil {
+		user.log.WithError(err).Error("Failed to add delivery status notification to inbox")
+	}
+}
+
+// readReturnedLiteral reads what of the message is returned to the sender: the whole message, or only its header.
+// The password protecting the message is removed, as it must not end up in the sender's inbox.
+func readReturnedLiteral(literal literalSource, full bool) ([]byte, error) {
+	var (
+		header, body []byte
+		err          error
+	)
+
+	if full {
+		b, readErr := readLiteral(literal)
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		header, body = rfc822.Split(b)
+	} else if header, err = readLiteralHeader(literal); err != nil {
+		return nil, err
+	}
+
+	if h, err := rfc822.NewHeader(header); err == nil {
+		h.Del(message.EOPasswordHeader)
+		header = h.Raw()
+	}
+
+	return append(bytes.Clone(header), body...), nil
+}
+
+// importDSN imports the given report into the inbox of the given address and publishes it over IMAP.
+// The same message will later be received as an API event; gluon ignores messages it already knows.
+func (user *User) importDSN(from string, report []byte) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	return safe.RLockRet(func() error {
+		addrID, err := getAddrID(user.apiAddrs, from)
+		if err != nil {
+			primAddr, err := getPrimaryAddr(user.apiAddrs)
+			if err != nil {
+				return fmt.Errorf("failed to get primary address: %w", err)
+			}
+
+			addrID = primAddr.ID
+		}
+
+		return withAddrKR(user.apiUser, user.apiAddrs[addrID], user.vault.KeyPass(), func(_, addrKR *crypto.KeyRing) error {
+			str, err := user.client.ImportMessages(ctx, addrKR, 1, 1, []proton.ImportReq{{
+				Metadata: proton.ImportMetadata{
+					AddressID: addrID,
+					LabelIDs:  []string{proton.InboxLabel},
+					Unread:    proton.Bool(true),
+					Flags:     proton.MessageFlagReceived,
+				},
+				Message: report,
+			}}...)
+			if err != nil {
+				return fmt.Errorf("failed to prepare message for import: %w", err)
+			}
+
+			res, err := stream.Collect(ctx, str)
+			if err != nil {
+				return fmt.Errorf("failed to import message: %w", err)
+			}
+
+			full, err := user.client.GetFullMessage(ctx, res[0].MessageID, newProtonAPIScheduler(user.panicHandler), proton.NewDefaultAttachmentAllocator())
+			if err != nil {
+				return fmt.Errorf("failed to fetch message: %w", err)
+			}
+
+			build := buildRFC822(user.apiLabels, full, addrKR, new(bytes.Buffer))
+			if build.err != nil {
+				return fmt.Errorf("failed to build message: %w", build.err)
+			}
+
+			if _, err := safePublishMessageUpdate(user, addrID, imap.NewMessagesCreated(false, build.update)); err != nil {
+				return fmt.Errorf("failed to publish message: %w", err)
+			}
+
+			return nil
+		})
+	}, user.apiUserLock, user.apiAddrsLock, user.apiLabelsLock, user.updateChLock)
+}
+
+// buildDSN builds a multipart/report delivery status notification (RFC 3464) for the given failures.
+// The original message is returned as is if the sender asked for the whole message, otherwise it holds only the header.
+func buildDSN(from string, arrival time.Time, dsn DSN, original []byte, failures []deliveryFailure) ([]byte, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+
+	boundary := uuid.NewString()
+
+	var hdr textproto.Header
+
+	hdr.Set("From", "Mail Delivery System <MAILER-DAEMON@"+hostname+">")
+	hdr.Set("To", "<"+from+">")
+	hdr.Set("Subject", "Undelivered Mail Returned to Sender")
+	hdr.Set("Date", time.Now().Format(time.RFC1123Z))
+	hdr.Set("Message-Id", "<"+uuid.NewString()+"@"+hostname+">")
+	hdr.Set("Auto-Submitted", "auto-replied")
+	hdr.Set("Mime-Version", "1.0")
+	hdr.Set("Content-Type", `multipart/report; report-type=delivery-status; boundary="`+boundary+`"`)
+
+	buf := new(bytes.Buffer)
+
+	if err := textproto.WriteHeader(buf, hdr); err != nil {
+		return nil, err
+	}
+
+	// The human-readable part.
+	writePart(buf, boundary, "text/plain; charset=utf-8")
+
+	fmt.Fprintf(buf, "Your message could not be delivered to the following recipients:\r\n\r\n")
+
+	for _, failure := range failures {
+		fmt.Fprintf(buf, "<%v>: %v\r\n", failure.recipient, failure.err)
+	}
+
+	// The machine-readable part.
+	writePart(buf, boundary, "message/delivery-status")
+
+	if dsn.EnvelopeID != "" {
+		fmt.Fprintf(buf, "Original-Envelope-Id: %v\r\n", strings.Join(strings.Fields(dsn.EnvelopeID), " "))
+	}
+
+	fmt.Fprintf(buf, "Reporting-MTA: dns; %v\r\n", hostname)
+	fmt.Fprintf(buf, "Arrival-Date: %v\r\n", arrival.Format(time.RFC1123Z))
+
+	for _, failure := range failures {
+		fmt.Fprintf(buf, "\r\n")
+
+		if failure.original != "" {
+			fmt.Fprintf(buf, "Original-Recipient: %v\r\n", failure.original)
+		}
+
+		fmt.Fprintf(buf, "Final-Recipient: rfc822; %v\r\n", failure.recipient)
+		fmt.Fprintf(buf, "Action: failed\r\n")
+		fmt.Fprintf(buf, "Status: %v\r\n", failure.status)
+		fmt.Fprintf(buf, "Diagnostic-Code: X-Proton; %v\r\n", strings.Join(strings.Fields(failure.err.Error()), " "))
+	}
+
+	// The original message, or only its header.
+	if dsn.ReturnFull {
+		writePart(buf, boundary, "message/rfc822")
+
+		buf.Write(original)
+	} else {
+		writePart(buf, boundary, "text/rfc822-headers")
+
+		buf.Write(bytes.TrimRight(original, "\r\n"))
+		buf.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(buf, "\r\n--%v--\r\n", boundary)
+
+	return buf.Bytes(), nil
+}
+
+// writePart writes the delimiter and header of a new part with the given content type.
+func writePart(buf *bytes.Buffer, boundary, contentType string) {
+	fmt.Fprintf(buf, "\r\n--%v\r\nContent-Type: %v\r\n\r\n", boundary, contentType)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeliveryFailures(t *testing.T) {
+	err := fmt.Errorf("failed to send message: %w", recipientErrors{
+		"missing@example.com": &proton.APIError{Status: http.StatusUnprocessableEntity, Message: "Address does not exist"},
+	})
+
+	failures := getDeliveryFailures(
+		[]string{"missing@example.com", "other@example.com", "quiet@example.com"},
+		map[string]DSNRecipient{
+			"other@example.com": {Notify: DSNNotifyFailure, OriginalRecipient: "rfc822;Other@example.com"},
+			"quiet@example.com": {Notify: DSNNotifyNever},
+		},
+		err,
+	)
+
+	require.Len(t, failures, 2)
+
+	require.Equal(t, "missing@example.com", failures[0].recipient)
+	require.Equal(t, "5.1.1", failures[0].status)
+
+	require.Equal(t, "other@example.com", failures[1].recipient)
+	require.Equal(t, "rfc822;Other@example.com", failures[1].original)
+	require.Equal(t, "5.0.0", failures[1].status)
+	require.Equal(t, err, failures[1].err)
+}
+
+func TestBuildDSN(t *testing.T) {
+	literal := memLiteral("From: sender@pm.me\r\nTo: missing@example.com\r\nSubject: Hello\r\n\r\nSecret body\r\n")
+
+	header, err := readReturnedLiteral(literal, false)
+	require.NoError(t, err)
+
+	report, err := buildDSN("sender@pm.me", time.Now(), DSN{}, header, []deliveryFailure{{
+		recipient: "missing@example.com",
+		original:  "rfc822;missing@example.com",
+		status:    "5.1.1",
+		err:       errors.New("address\ndoes not exist"),
+	}})
+	require.NoError(t, err)
+
+	reportHeader, err := rfc822.Parse(report).ParseHeader()
+	require.NoError(t, err)
+	require.Equal(t, "<sender@pm.me>", reportHeader.Get("To"))
+	require.Equal(t, "auto-replied", reportHeader.Get("Auto-Submitted"))
+
+	contentType, params, err := rfc822.ParseMIMEType(reportHeader.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, rfc822.MIMEType("multipart/report"), contentType)
+	require.Equal(t, "delivery-status", params["report-type"])
+
+	parts, err := rfc822.Parse(report).Children()
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	status := string(parts[1].Body())
+	require.Contains(t, status, "Original-Recipient: rfc822;missing@example.com\r\nFinal-Recipient: rfc822; missing@example.com\r\n")
+	require.Contains(t, status, "Action: failed\r\n")
+	require.Contains(t, status, "Status: 5.1.1\r\n")
+	require.Contains(t, status, "Diagnostic-Code: X-Proton; address does not exist\r\n")
+
+	headers := string(parts[2].Body())
+	require.Contains(t, headers, "Subject: Hello")
+	require.NotContains(t, headers, "Secret body")
+}
+
+func TestBuildDSN_ReturnFull(t *testing.T) {
+	literal := memLiteral("From: sender@pm.me\r\nTo: missing@example.com\r\nX-Pm-Eo-Password: secret\r\nSubject: Hello\r\n\r\nSecret body\r\n")
+
+	original, err := readReturnedLiteral(literal, true)
+	require.NoError(t, err)
+
+	dsn := DSN{ReturnFull: true, EnvelopeID: "QQ314159"}
+
+	report, err := buildDSN("sender@pm.me", time.Now(), dsn, original, []deliveryFailure{{
+		recipient: "missing@example.com",
+		status:    "5.1.1",
+		err:       errors.New("address does not exist"),
+	}})
+	require.NoError(t, err)
+
+	parts, err := rfc822.Parse(report).Children()
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	require.Contains(t, string(parts[1].Body()), "Original-Envelope-Id: QQ314159\r\n")
+
+	returnedHeader, err := parts[2].ParseHeader()
+	require.NoError(t, err)
+	require.Equal(t, "message/rfc822", returnedHeader.Get("Content-Type"))
+
+	returned := string(parts[2].Body())
+	require.Contains(t, returned, "Subject: Hello")
+	require.Contains(t, returned, "Secret body")
+	require.NotContains(t, returned, "secret")
+}
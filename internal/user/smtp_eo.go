@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-srp"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+)
+
+const (
+	// eoSaltLength is the length of the salt of the verifier of a message password.
+	eoSaltLength = 10
+
+	// eoTokenLength is the length of the token recipients decrypt to prove they know the message password.
+	eoTokenLength = 32
+
+	// eoVerifierBits is the bit length of the verifier of a message password.
+	eoVerifierBits = 2048
+)
+
+// ModulusProvider provides the SRP modulus, which is needed to protect messages with a password.
+type ModulusProvider interface {
+	AuthModulus(ctx context.Context) (proton.AuthModulus, error)
+}
+
+// eoPassword protects messages sent to external recipients with a password (encrypted outside).
+// Rather than getting the message in the clear, the recipients read it on Proton's website after entering the password.
+type eoPassword struct {
+	password []byte
+	hint     string
+	auth     proton.AuthVerifier
+}
+
+// newEOPassword prepares protecting messages with the given password.
+// It generates the verifier the API uses to check that recipients know the password.
+func newEOPassword(modulus proton.AuthModulus, password, hint string) (*eoPassword, error) {
+	salt, err := srp.RandomBytes(eoSaltLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	auth, err := srp.NewAuthForVerifier([]byte(password), modulus.Modulus, salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create auth: %w", err)
+	}
+
+	verifier, err := auth.GenerateVerifier(eoVerifierBits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate verifier: %w", err)
+	}
+
+	return &eoPassword{
+		password: []byte(password),
+		hint:     hint,
+		auth: proton.AuthVerifier{
+			Version:   auth.Version,
+			ModulusID: modulus.ModulusID,
+			Salt:      base64.StdEncoding.EncodeToString(salt),
+			Verifier:  base64.StdEncoding.EncodeToString(verifier),
+		},
+	}, nil
+}
+
+// protect sets the fields of a password-protected recipient: the session keys are encrypted with the password.
+func (eo *eoPassword) protect(recipient *proton.MessageRecipient, bodyKey *crypto.SessionKey, attKeys map[string]*crypto.SessionKey) error {
+	if err := encryptSessionKeys(recipient, bodyKey, attKeys, func(key *crypto.SessionKey) ([]byte, error) {
+		return crypto.EncryptSessionKeyWithPassword(key, eo.password)
+	}); err != nil {
+		return err
+	}
+
+	token, err := crypto.RandomToken(eoTokenLength)
+	if err != nil {
+		return fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	encToken, err := crypto.EncryptMessageWithPassword(crypto.NewPlainMessageFromString(base64.StdEncoding.EncodeToString(token)), eo.password)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt token: %w", err)
+	}
+
+	armToken, err := encToken.GetArmored()
+	if err != nil {
+		return fmt.Errorf("failed to armor token: %w", err)
+	}
+
+	recipient.Token = base64.StdEncoding.EncodeToString(token)
+	recipient.EncToken = armToken
+	recipient.Auth = &eo.auth
+	recipient.PasswordHint = eo.hint
+
+	return nil
+}
+
+// protect makes the recipients who would get the message in the clear get it password-protected instead.
+// The message body is sent with the given MIME type to those who would have gotten the whole MIME message.
+func (r recipients) protect(mimeType rfc822.MIMEType) {
+	for addr, prefs := range r.scheme(proton.ClearScheme, proton.ClearMIMEScheme) {
+		prefs.EncryptionScheme = proton.EncryptedOutsideScheme
+		prefs.SignatureType = proton.NoSignature
+
+		if prefs.MIMEType == rfc822.MultipartMixed {
+			prefs.MIMEType = mimeType
+		}
+
+		r[addr] = prefs
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/go-srp"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipients_Protect(t *testing.T) {
+	recipients := recipients{
+		"internal@pm.me":  {EncryptionScheme: proton.InternalScheme, SignatureType: proton.DetachedSignature, MIMEType: rfc822.TextHTML},
+		"clear@pm.test":   {EncryptionScheme: proton.ClearScheme, SignatureType: proton.DetachedSignature, MIMEType: rfc822.TextPlain},
+		"mime@pm.test":    {EncryptionScheme: proton.ClearMIMEScheme, SignatureType: proton.DetachedSignature, MIMEType: rfc822.MultipartMixed},
+		"pgpmime@pm.test": {EncryptionScheme: proton.PGPMIMEScheme, SignatureType: proton.DetachedSignature, MIMEType: rfc822.MultipartMixed},
+	}
+
+	recipients.protect(rfc822.TextHTML)
+
+	// Recipients who get the message encrypted are left alone.
+	require.Equal(t, proton.InternalScheme, recipients["internal@pm.me"].EncryptionScheme)
+	require.Equal(t, proton.PGPMIMEScheme, recipients["pgpmime@pm.test"].EncryptionScheme)
+
+	// The others get it password-protected, with a text body.
+	require.Equal(t, proton.SendPreferences{
+		EncryptionScheme: proton.EncryptedOutsideScheme,
+		SignatureType:    proton.NoSignature,
+		MIMEType:         rfc822.TextPlain,
+	}, recipients["clear@pm.test"])
+
+	require.Equal(t, proton.SendPreferences{
+		EncryptionScheme: proton.EncryptedOutsideScheme,
+		SignatureType:    proton.NoSignature,
+		MIMEType:         rfc822.TextHTML,
+	}, recipients["mime@pm.test"])
+}
+
+func TestNewTextPackage_EO(t *testing.T) {
+	withAPI(t, context.Background(), func(ctx context.Context, s *server.Server, m *proton.Manager) {
+		withAccount(t, s, "username", "password", []string{}, func(string, []string) {
+			info, err := m.AuthInfo(ctx, proton.AuthInfoReq{Username: "username"})
+			require.NoError(t, err)
+
+			key, err := crypto.GenerateKey("name", "name@pm.me", "x25519", 0)
+			require.NoError(t, err)
+
+			kr, err := crypto.NewKeyRing(key)
+			require.NoError(t, err)
+
+			attKey, err := crypto.GenerateSessionKey()
+			require.NoError(t, err)
+
+			eo, err := newEOPassword(proton.AuthModulus{Modulus: info.Modulus, ModulusID: "modulusID"}, "secret", "the usual")
+			require.NoError(t, err)
+
+			pkg, err := newTextPackage(kr, "body", rfc822.TextHTML, recipients{
+				"external@pm.test": {EncryptionScheme: proton.EncryptedOutsideScheme, MIMEType: rfc822.TextHTML},
+			}, map[string]*crypto.SessionKey{"attID": attKey}, eo)
+			require.NoError(t, err)
+			require.Equal(t, proton.EncryptedOutsideScheme, pkg.Type)
+
+			recipient := pkg.Addresses["external@pm.test"]
+			require.Equal(t, "the usual", recipient.PasswordHint)
+
+			// The body can be decrypted with the password.
+			bodyKeyPacket, err := base64.StdEncoding.DecodeString(recipient.BodyKeyPacket)
+			require.NoError(t, err)
+
+			bodyKey, err := crypto.DecryptSessionKeyWithPassword(bodyKeyPacket, []byte("secret"))
+			require.NoError(t, err)
+
+			encBody, err := base64.StdEncoding.DecodeString(pkg.Body)
+			require.NoError(t, err)
+
+			body, err := bodyKey.Decrypt(encBody)
+			require.NoError(t, err)
+			require.Equal(t, "body", body.GetString())
+
+			// So can the attachment keys.
+			attKeyPacket, err := base64.StdEncoding.DecodeString(recipient.AttachmentKeyPackets["attID"])
+			require.NoError(t, err)
+
+			decAttKey, err := crypto.DecryptSessionKeyWithPassword(attKeyPacket, []byte("secret"))
+			require.NoError(t, err)
+			require.Equal(t, attKey.GetBase64Key(), decAttKey.GetBase64Key())
+
+			// So can the token.
+			encToken, err := crypto.NewPGPMessageFromArmored(recipient.EncToken)
+			require.NoError(t, err)
+
+			token, err := crypto.DecryptMessageWithPassword(encToken, []byte("secret"))
+			require.NoError(t, err)
+			require.Equal(t, recipient.Token, token.GetString())
+
+			// The verifier lets recipients who know the password authenticate.
+			verifier, err := base64.StdEncoding.DecodeString(recipient.Auth.Verifier)
+			require.NoError(t, err)
+
+			srpServer, err := srp.NewServerFromSigned(info.Modulus, verifier, eoVerifierBits)
+			require.NoError(t, err)
+
+			challenge, err := srpServer.GenerateChallenge()
+			require.NoError(t, err)
+
+			srpAuth, err := srp.NewAuth(
+				recipient.Auth.Version,
+				"",
+				[]byte("secret"),
+				recipient.Auth.Salt,
+				info.Modulus,
+				base64.StdEncoding.EncodeToString(challenge),
+			)
+			require.NoError(t, err)
+
+			proofs, err := srpAuth.GenerateProofs(eoVerifierBits)
+			require.NoError(t, err)
+
+			_, err = srpServer.VerifyProofs(proofs.ClientEphemeral, proofs.ClientProof)
+			require.NoError(t, err)
+		})
+	})
+}
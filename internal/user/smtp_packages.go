@@ -18,6 +18,9 @@
 package user
 
 import (
+	"encoding/base64"
+	"fmt"
+
 	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
@@ -33,6 +36,7 @@ func createSendReq(
 	richBody, plainBody message.Body,
 	recipients recipients,
 	attKeys map[string]*crypto.SessionKey,
+	eo *eoPassword,
 ) (proton.SendDraftReq, error) {
 	var req proton.SendDraftReq
 
@@ -42,21 +46,147 @@ func createSendReq(
 		}
 	}
 
-	if recs := recipients.scheme(proton.InternalScheme, proton.ClearScheme, proton.PGPInlineScheme); len(recs) > 0 {
-		if recs := recs.content(rfc822.TextHTML); len(recs) > 0 {
-			if err := req.AddTextPackage(kr, string(richBody), rfc822.TextHTML, recs, attKeys); err != nil {
-				return proton.SendDraftReq{}, err
+	if recs := recipients.scheme(proton.InternalScheme, proton.ClearScheme, proton.PGPInlineScheme, proton.EncryptedOutsideScheme); len(recs) > 0 {
+		for _, part := range []struct {
+			mimeType rfc822.MIMEType
+			body     message.Body
+		}{
+			{mimeType: rfc822.TextHTML, body: richBody},
+			{mimeType: rfc822.TextPlain, body: plainBody},
+		} {
+			recs := recs.content(part.mimeType)
+
+			switch {
+			case len(recs) == 0:
+				continue
+
+			// go-proton-api can't build packages for password-protected recipients, so we build them ourselves.
+			case len(recs.scheme(proton.EncryptedOutsideScheme)) > 0:
+				pkg, err := newTextPackage(kr, string(part.body), part.mimeType, recs, attKeys, eo)
+				if err != nil {
+					return proton.SendDraftReq{}, err
+				}
+
+				req.Packages = append(req.Packages, pkg)
+
+			default:
+				if err := req.AddTextPackage(kr, string(part.body), part.mimeType, recs, attKeys); err != nil {
+					return proton.SendDraftReq{}, err
+				}
 			}
 		}
+	}
+
+	return req, nil
+}
+
+// newTextPackage builds a text package like proton.SendDraftReq.AddTextPackage does,
+// but also for password-protected recipients, which go-proton-api can't encrypt for.
+func newTextPackage(
+	kr *crypto.KeyRing,
+	body string,
+	mimeType rfc822.MIMEType,
+	recipients recipients,
+	attKeys map[string]*crypto.SessionKey,
+	eo *eoPassword,
+) (*proton.MessagePackage, error) {
+	encBody, err := kr.Encrypt(crypto.NewPlainMessageFromString(body), kr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt message body: %w", err)
+	}
+
+	splitBody, err := encBody.SplitMessage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to split message: %w", err)
+	}
+
+	bodyKey, err := kr.DecryptSessionKey(splitBody.GetBinaryKeyPacket())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt session key: %w", err)
+	}
+
+	pkg := &proton.MessagePackage{
+		Addresses:      make(map[string]*proton.MessageRecipient),
+		MIMEType:       mimeType,
+		Body:           base64.StdEncoding.EncodeToString(splitBody.GetBinaryDataPacket()),
+		AttachmentKeys: make(map[string]*proton.SessionKey),
+	}
+
+	for addr, prefs := range recipients {
+		recipient := &proton.MessageRecipient{
+			Type:                 prefs.EncryptionScheme,
+			Signature:            prefs.SignatureType,
+			AttachmentKeyPackets: make(map[string]string),
+		}
+
+		switch prefs.EncryptionScheme {
+		case proton.ClearScheme:
+			pkg.BodyKey = newSessionKey(bodyKey)
 
-		if recs := recs.content(rfc822.TextPlain); len(recs) > 0 {
-			if err := req.AddTextPackage(kr, string(plainBody), rfc822.TextPlain, recs, attKeys); err != nil {
-				return proton.SendDraftReq{}, err
+			for attID, attKey := range attKeys {
+				pkg.AttachmentKeys[attID] = newSessionKey(attKey)
 			}
+
+		case proton.InternalScheme, proton.PGPInlineScheme:
+			if prefs.PubKey == nil {
+				return nil, fmt.Errorf("missing public key for %s", addr)
+			}
+
+			if err := encryptSessionKeys(recipient, bodyKey, attKeys, prefs.PubKey.EncryptSessionKey); err != nil {
+				return nil, err
+			}
+
+		case proton.EncryptedOutsideScheme:
+			if eo == nil {
+				return nil, fmt.Errorf("missing password for %s", addr)
+			}
+
+			if err := eo.protect(recipient, bodyKey, attKeys); err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, fmt.Errorf("invalid encryption scheme for package: %d", prefs.EncryptionScheme)
 		}
+
+		pkg.Addresses[addr] = recipient
+		pkg.Type |= prefs.EncryptionScheme
 	}
 
-	return req, nil
+	return pkg, nil
+}
+
+// encryptSessionKeys sets the body and attachment key packets of the recipient, encrypted with the given function.
+func encryptSessionKeys(
+	recipient *proton.MessageRecipient,
+	bodyKey *crypto.SessionKey,
+	attKeys map[string]*crypto.SessionKey,
+	encrypt func(*crypto.SessionKey) ([]byte, error),
+) error {
+	encBodyKey, err := encrypt(bodyKey)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt session key: %w", err)
+	}
+
+	recipient.BodyKeyPacket = base64.StdEncoding.EncodeToString(encBodyKey)
+
+	for attID, attKey := range attKeys {
+		encAttKey, err := encrypt(attKey)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt attachment key: %w", err)
+		}
+
+		recipient.AttachmentKeyPackets[attID] = base64.StdEncoding.EncodeToString(encAttKey)
+	}
+
+	return nil
+}
+
+func newSessionKey(key *crypto.SessionKey) *proton.SessionKey {
+	return &proton.SessionKey{
+		Key:       key.GetBase64Key(),
+		Algorithm: key.Algo,
+	}
 }
 
 type recipients map[string]proton.SendPreferences
@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+)
+
+// recipientCacheExpiry is how long a recipient lookup made during RCPT can be reused to send the message.
+const recipientCacheExpiry = 5 * time.Minute
+
+// recipientLookupTimeout is how long RCPT waits for the lookup of a recipient before accepting it unchecked.
+var recipientLookupTimeout = 5 * time.Second
+
+// recipientInfo holds what is needed to build the send preferences of a recipient.
+type recipientInfo struct {
+	pubKeys         []proton.PublicKey
+	recType         proton.RecipientType
+	contactSettings proton.ContactSettings
+}
+
+type recipientCache struct {
+	expiry time.Duration
+
+	entries     map[string]recipientCacheEntry
+	entriesLock sync.Mutex
+}
+
+type recipientCacheEntry struct {
+	info recipientInfo
+	exp  time.Time
+}
+
+func newRecipientCache(expiry time.Duration) *recipientCache {
+	return &recipientCache{
+		expiry:  expiry,
+		entries: make(map[string]recipientCacheEntry),
+	}
+}
+
+// put stores the lookup of the given recipient.
+func (c *recipientCache) put(recipient string, info recipientInfo) {
+	c.entriesLock.Lock()
+	defer c.entriesLock.Unlock()
+
+	for recipient, entry := range c.entries {
+		if entry.exp.Before(time.Now()) {
+			delete(c.entries, recipient)
+		}
+	}
+
+	c.entries[recipient] = recipientCacheEntry{
+		info: info,
+		exp:  time.Now().Add(c.expiry),
+	}
+}
+
+// take returns and removes the lookup of the given recipient, if one was stored and has not expired.
+func (c *recipientCache) take(recipient string) (recipientInfo, bool) {
+	c.entriesLock.Lock()
+	defer c.entriesLock.Unlock()
+
+	entry, ok := c.entries[recipient]
+	if !ok {
+		return recipientInfo{}, false
+	}
+
+	delete(c.entries, recipient)
+
+	if entry.exp.Before(time.Now()) {
+		return recipientInfo{}, false
+	}
+
+	return entry.info, true
+}
+
+// CheckRecipient looks up the given recipient and returns an error if sending to it would certainly fail.
+// Errors which may not be permanent, e.g. if the API is unreachable or too slow, are ignored: they will surface when sending.
+// The lookup is cached so that it doesn't need to be repeated when the message is sent.
+func (user *User) CheckRecipient(recipient string) error {
+	if _, err := mail.ParseAddress(recipient); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidRecipient, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), recipientLookupTimeout)
+	defer cancel()
+
+	info, err := safe.RLockRetErr(func() (recipientInfo, error) {
+		userKR, err := user.apiUser.Keys.Unlock(user.vault.KeyPass(), nil)
+		if err != nil {
+			return recipientInfo{}, fmt.Errorf("failed to unlock user keys: %w", err)
+		}
+		defer userKR.ClearPrivateParams()
+
+		return lookupRecipient(ctx, user.client, userKR, recipient)
+	}, user.apiUserLock)
+	if err != nil {
+		// The API rejects key lookups for addresses which do not exist.
+		if apiErr := new(proton.APIError); errors.As(err, &apiErr) && apiErr.Status == http.StatusUnprocessableEntity {
+			return fmt.Errorf("%w: %v", ErrNoSuchRecipient, apiErr.Message)
+		}
+
+		// The client should not wait on the lookup; the recipient will be checked again when sending.
+		if errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil {
+			user.log.WithField("recipient", logging.Sensitive(recipient)).Warn("Recipient lookup timed out")
+			return nil
+		}
+
+		user.log.WithField("recipient", logging.Sensitive(recipient)).WithError(err).Warn("Failed to look up recipient")
+
+		return nil
+	}
+
+	// Internal recipients always have an active key, unless their address is disabled.
+	if info.recType == proton.RecipientTypeInternal && len(info.pubKeys) == 0 {
+		return ErrRecipientDisabled
+	}
+
+	user.recipientCache.put(recipient, info)
+
+	return nil
+}
+
+// lookupRecipient fetches the public keys and contact settings of the given recipient.
+func lookupRecipient(
+	ctx context.Context,
+	client *proton.Client,
+	userKR *crypto.KeyRing,
+	recipient string,
+) (recipientInfo, error) {
+	pubKeys, recType, err := client.GetPublicKeys(ctx, recipient)
+	if err != nil {
+		return recipientInfo{}, fmt.Errorf("failed to get public key for %v: %w", recipient, err)
+	}
+
+	contactSettings, err := getContactSettings(ctx, client, userKR, recipient)
+	if err != nil {
+		return recipientInfo{}, fmt.Errorf("failed to get contact settings for %v: %w", recipient, err)
+	}
+
+	return recipientInfo{
+		pubKeys:         pubKeys,
+		recType:         recType,
+		contactSettings: contactSettings,
+	}, nil
+}
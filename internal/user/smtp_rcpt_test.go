@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipientCache(t *testing.T) {
+	c := newRecipientCache(time.Second)
+
+	c.put("a@pm.me", recipientInfo{recType: proton.RecipientTypeInternal})
+
+	// The entry can be taken only once.
+	info, ok := c.take("a@pm.me")
+	require.True(t, ok)
+	require.Equal(t, proton.RecipientTypeInternal, info.recType)
+
+	_, ok = c.take("a@pm.me")
+	require.False(t, ok)
+
+	// Expired entries are not returned.
+	c.put("b@pm.me", recipientInfo{})
+
+	time.Sleep(time.Second)
+
+	_, ok = c.take("b@pm.me")
+	require.False(t, ok)
+}
+
+func TestUser_CheckRecipient(t *testing.T) {
+	withAPI(t, context.Background(), func(ctx context.Context, s *server.Server, m *proton.Manager) {
+		withAccount(t, s, "username", "password", []string{}, func(string, []string) {
+			withAccount(t, s, "other", "password", []string{}, func(string, []string) {
+				withUser(t, ctx, s, m, "username", "password", func(user *User) {
+					// Malformed addresses are rejected.
+					require.ErrorIs(t, user.CheckRecipient("not an address"), ErrInvalidRecipient)
+
+					// Internal and external recipients are accepted, and their lookup is cached.
+					require.NoError(t, user.CheckRecipient("other@"+s.GetDomain()))
+					require.NoError(t, user.CheckRecipient("someone@example.com"))
+
+					info, ok := user.recipientCache.take("other@" + s.GetDomain())
+					require.True(t, ok)
+					require.Equal(t, proton.RecipientTypeInternal, info.recType)
+					require.NotEmpty(t, info.pubKeys)
+				})
+			})
+		})
+	})
+}
+
+func TestUser_CheckRecipient_Timeout(t *testing.T) {
+	withAPI(t, context.Background(), func(ctx context.Context, s *server.Server, m *proton.Manager) {
+		withAccount(t, s, "username", "password", []string{}, func(string, []string) {
+			withUser(t, ctx, s, m, "username", "password", func(user *User) {
+				defer func(timeout time.Duration) { recipientLookupTimeout = timeout }(recipientLookupTimeout)
+
+				recipientLookupTimeout = 100 * time.Millisecond
+
+				// The key lookup hangs for longer than the client is willing to wait.
+				s.AddStatusHook(func(req *http.Request) (int, bool) {
+					if strings.HasPrefix(req.URL.Path, "/core/v4/keys") {
+						time.Sleep(time.Second)
+					}
+
+					return 0, false
+				})
+
+				start := time.Now()
+
+				// The recipient is accepted unchecked, and nothing is cached.
+				require.NoError(t, user.CheckRecipient("someone@example.com"))
+				require.Less(t, time.Since(start), time.Second)
+
+				_, ok := user.recipientCache.take("someone@example.com")
+				require.False(t, ok)
+			})
+		})
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
+)
+
+const (
+	// scheduleSendHeader is the header clients set to schedule a message, with an RFC 3339 time.
+	scheduleSendHeader = "X-Pm-Schedule-Send"
+
+	// deferredDeliveryHeader is the header some clients set to defer the delivery of a message (RFC 4021).
+	deferredDeliveryHeader = "Deferred-Delivery"
+)
+
+// getDeliveryTime returns the time at which the message should be sent, if the client asked to send it later,
+// and removes the headers used to ask for it. It returns the zero time if the message should be sent now.
+func getDeliveryTime(parser *parser.Parser) (time.Time, error) {
+	header := &parser.Root().Header
+
+	defer func() {
+		header.Del(scheduleSendHeader)
+		header.Del(deferredDeliveryHeader)
+	}()
+
+	var (
+		deliveryTime time.Time
+		err          error
+	)
+
+	if value := header.Get(scheduleSendHeader); value != "" {
+		if deliveryTime, err = time.Parse(time.RFC3339, value); err != nil {
+			return time.Time{}, fmt.Errorf("invalid %v header: %w", scheduleSendHeader, err)
+		}
+	} else if value := header.Get(deferredDeliveryHeader); value != "" {
+		if deliveryTime, err = mail.ParseDate(value); err != nil {
+			return time.Time{}, fmt.Errorf("invalid %v header: %w", deferredDeliveryHeader, err)
+		}
+	}
+
+	// There is nothing to schedule if the time has already passed.
+	if deliveryTime.Before(time.Now()) {
+		return time.Time{}, nil
+	}
+
+	return deliveryTime, nil
+}
+
+// cancelScheduledSend cancels sending the given scheduled message; the message becomes a draft again.
+func cancelScheduledSend(ctx context.Context, client *proton.Client, messageID string) error {
+	if _, err := client.CancelSendMessage(ctx, messageID); err != nil {
+		return fmt.Errorf("failed to cancel sending message: %w", err)
+	}
+
+	return nil
+}
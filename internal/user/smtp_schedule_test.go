@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeliveryTime(t *testing.T) {
+	later := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name    string
+		header  string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "none", header: ""},
+		{name: "schedule send", header: "X-Pm-Schedule-Send: " + later.Format(time.RFC3339) + "\r\n", want: later},
+		{name: "deferred delivery", header: "Deferred-Delivery: " + later.Format(time.RFC1123Z) + "\r\n", want: later},
+		{name: "past", header: "X-Pm-Schedule-Send: 2000-01-01T00:00:00Z\r\n"},
+		{name: "invalid", header: "X-Pm-Schedule-Send: tomorrow\r\n", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := parser.New(strings.NewReader("Subject: Hello\r\n" + test.header + "Content-Type: text/plain\r\n\r\nBody\r\n"))
+			require.NoError(t, err)
+
+			deliveryTime, err := getDeliveryTime(p)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, test.want.Equal(deliveryTime))
+
+			// The headers are not sent.
+			require.Empty(t, p.Root().Header.Get("X-Pm-Schedule-Send"))
+			require.Empty(t, p.Root().Header.Get("Deferred-Delivery"))
+			require.Equal(t, "Hello", p.Root().Header.Get("Subject"))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// literalSource provides the literal of a message being sent. The literal may be read several times.
+type literalSource interface {
+	NewReader() (io.Reader, error)
+}
+
+// memLiteral is a literal held in memory.
+type memLiteral []byte
+
+func (literal memLiteral) NewReader() (io.Reader, error) {
+	return bytes.NewReader(literal), nil
+}
+
+// spooledLiteral is a literal stored in a temporary file rather than in memory, as messages may be large.
+// The file is encrypted with a random key which is never written to disk.
+type spooledLiteral struct {
+	file *os.File
+	size int64
+
+	block cipher.Block
+	iv    []byte
+}
+
+// newSpooledLiteral writes the literal read from r to a temporary file.
+// If limit is not negative and the literal is larger, it fails with ErrQuotaExceeded.
+func newSpooledLiteral(r io.Reader, limit int64) (*spooledLiteral, error) {
+	key := make([]byte, 32)
+
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	iv := make([]byte, block.BlockSize())
+
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("failed to generate IV: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "bridge-smtp-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	literal := &spooledLiteral{file: file, block: block, iv: iv}
+
+	if limit >= 0 {
+		r = io.LimitReader(r, limit+1)
+	}
+
+	size, err := io.Copy(&cipher.StreamWriter{S: cipher.NewCTR(block, iv), W: file}, r)
+	if err != nil {
+		_ = literal.Close()
+		return nil, fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if limit >= 0 && size > limit {
+		_ = literal.Close()
+		return nil, ErrQuotaExceeded
+	}
+
+	literal.size = size
+
+	return literal, nil
+}
+
+// Size returns the size of the literal in bytes.
+func (literal *spooledLiteral) Size() int64 {
+	return literal.size
+}
+
+func (literal *spooledLiteral) NewReader() (io.Reader, error) {
+	return &cipher.StreamReader{
+		S: cipher.NewCTR(literal.block, literal.iv),
+		R: io.NewSectionReader(literal.file, 0, literal.size),
+	}, nil
+}
+
+// Close removes the temporary file.
+func (literal *spooledLiteral) Close() error {
+	closeErr := literal.file.Close()
+
+	if err := os.Remove(literal.file.Name()); err != nil {
+		return err
+	}
+
+	return closeErr
+}
+
+// readLiteral reads the whole literal into memory.
+func readLiteral(source literalSource) ([]byte, error) {
+	if literal, ok := source.(memLiteral); ok {
+		return literal, nil
+	}
+
+	r, err := source.NewReader()
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+// readLiteralHeader reads the header of the literal, up to and including the blank line which ends it.
+func readLiteralHeader(source literalSource) ([]byte, error) {
+	r, err := source.NewReader()
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+
+	var header []byte
+
+	for {
+		line, err := br.ReadBytes('\n')
+
+		header = append(header, line...)
+
+		if errors.Is(err, io.EOF) || len(bytes.TrimRight(line, "\r\n")) == 0 {
+			return header, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
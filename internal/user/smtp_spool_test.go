@@ -0,0 +1,71 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpooledLiteral(t *testing.T) {
+	literal := []byte("Subject: Hello\r\nTo: someone@example.com\r\n\r\n" + strings.Repeat("body\r\n", 1000))
+
+	spooled, err := newSpooledLiteral(bytes.NewReader(literal), -1)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(literal)), spooled.Size())
+
+	// The literal is not written to disk in the clear.
+	onDisk, err := os.ReadFile(spooled.file.Name())
+	require.NoError(t, err)
+	require.NotContains(t, string(onDisk), "Subject: Hello")
+
+	// It can be read several times.
+	for i := 0; i < 2; i++ {
+		r, err := spooled.NewReader()
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, literal, b)
+	}
+
+	header, err := readLiteralHeader(spooled)
+	require.NoError(t, err)
+	require.Equal(t, "Subject: Hello\r\nTo: someone@example.com\r\n\r\n", string(header))
+
+	// The file is removed once closed.
+	require.NoError(t, spooled.Close())
+	require.NoFileExists(t, spooled.file.Name())
+}
+
+func TestSpooledLiteral_Limit(t *testing.T) {
+	spooled, err := newSpooledLiteral(strings.NewReader("12345"), 5)
+	require.NoError(t, err)
+	require.NoError(t, spooled.Close())
+
+	_, err = newSpooledLiteral(strings.NewReader("123456"), 5)
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+
+	_, err = newSpooledLiteral(strings.NewReader("1"), 0)
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+}
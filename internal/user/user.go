@@ -40,6 +40,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
 	"github.com/ProtonMail/proton-bridge/v3/internal/telemetry"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
@@ -55,6 +56,9 @@ var (
 	EventJitter = 20 * time.Second // nolint:gochecknoglobals,revive
 )
 
+// appPasswordLastUsedResolution is how precisely the last use of app passwords is recorded.
+const appPasswordLastUsedResolution = time.Minute
+
 type User struct {
 	log *logrus.Entry
 
@@ -63,6 +67,8 @@ type User struct {
 	reporter reporter.Reporter
 	sendHash *sendRecorder
 
+	recipientCache *recipientCache
+
 	eventCh   *async.QueuedChannel[events.Event]
 	eventLock safe.RWMutex
 
@@ -78,6 +84,9 @@ type User struct {
 	updateCh     map[string]*async.QueuedChannel[imap.Update]
 	updateChLock safe.RWMutex
 
+	imapScopes     map[int]vault.AppPasswordScope
+	imapScopesLock safe.RWMutex
+
 	tasks     *async.Group
 	syncAbort async.Abortable
 	pollAbort async.Abortable
@@ -86,6 +95,10 @@ type User struct {
 	pollAPIEventsCh chan chan struct{}
 	goPollAPIEvents func(wait bool)
 
+	outboxLock    safe.Mutex
+	outboxAbort   async.Abortable
+	goDrainOutbox func()
+
 	showAllMail uint32
 
 	maxSyncMemory uint64
@@ -95,6 +108,11 @@ type User struct {
 
 	configStatus     *configstatus.ConfigurationStatus
 	telemetryManager telemetry.Availability
+
+	modulusProvider ModulusProvider
+
+	authChecker IMAPAuthChecker
+
 	// goStatusProgress triggers a check/sending if progress is needed.
 	goStatusProgress func()
 }
@@ -111,6 +129,8 @@ func New(
 	maxSyncMemory uint64,
 	statsDir string,
 	telemetryManager telemetry.Availability,
+	modulusProvider ModulusProvider,
+	authChecker IMAPAuthChecker,
 ) (*User, error) {
 	logrus.WithField("userID", apiUser.ID).Info("Creating new user")
 
@@ -147,6 +167,8 @@ func New(
 		reporter: reporter,
 		sendHash: newSendRecorder(sendEntryExpiry),
 
+		recipientCache: newRecipientCache(recipientCacheExpiry),
+
 		eventCh:   async.NewQueuedChannel[events.Event](0, 0, crashHandler),
 		eventLock: safe.NewRWMutex(),
 
@@ -162,9 +184,14 @@ func New(
 		updateCh:     make(map[string]*async.QueuedChannel[imap.Update]),
 		updateChLock: safe.NewRWMutex(),
 
+		imapScopes:     make(map[int]vault.AppPasswordScope),
+		imapScopesLock: safe.NewRWMutex(),
+
 		tasks:           async.NewGroup(context.Background(), crashHandler),
 		pollAPIEventsCh: make(chan chan struct{}),
 
+		outboxLock: safe.NewMutex(),
+
 		showAllMail: b32(showAllMail),
 
 		maxSyncMemory: maxSyncMemory,
@@ -174,6 +201,10 @@ func New(
 
 		configStatus:     configStatus,
 		telemetryManager: telemetryManager,
+
+		modulusProvider: modulusProvider,
+
+		authChecker: authChecker,
 	}
 
 	// Check for status_progress when triggered.
@@ -265,6 +296,14 @@ func New(
 		}
 	})
 
+	// When triggered, send the messages waiting in the outbox.
+	user.goDrainOutbox = user.tasks.Trigger(func(ctx context.Context) {
+		user.outboxAbort.Do(ctx, func(ctx context.Context) {
+			user.drainOutbox(ctx)
+		})
+	})
+	defer user.goDrainOutbox()
+
 	return user, nil
 }
 
@@ -350,6 +389,7 @@ func (user *User) SetAddressMode(_ context.Context, mode vault.AddressMode) erro
 func (user *User) CancelSyncAndEventPoll() {
 	user.syncAbort.Abort()
 	user.pollAbort.Abort()
+	user.outboxAbort.Abort()
 }
 
 // BadEventFeedbackResync sends user feedback whether should do message re-sync.
@@ -423,6 +463,16 @@ func (user *User) GluonKey() []byte {
 	return user.vault.GluonKey()
 }
 
+// SetPendingGluonKey sets the key the user's gluon database is being re-encrypted with in the vault, or clears it if nil.
+func (user *User) SetPendingGluonKey(key []byte) error {
+	return user.vault.SetPendingGluonKey(key)
+}
+
+// PromotePendingGluonKey makes the pending gluon key the user's one in the vault.
+func (user *User) PromotePendingGluonKey() error {
+	return user.vault.PromotePendingGluonKey()
+}
+
 // BridgePass returns the user's bridge password, used for authentication over SMTP and IMAP.
 func (user *User) BridgePass() []byte {
 	return algo.B64RawEncode(user.vault.BridgePass())
@@ -480,29 +530,80 @@ func (user *User) NewIMAPConnectors() (map[string]connector.Connector, error) {
 }
 
 // SendMail sends an email from the given address to the given recipients.
-func (user *User) SendMail(authID string, from string, to []string, r io.Reader) error {
-	if user.vault.SyncStatus().IsComplete() {
-		defer user.goPollAPIEvents(true)
-	}
-
+// If the API is unreachable or temporarily unavailable, the message is queued in the user's outbox instead.
+// If sending fails for any other reason, a delivery status notification is added to the sender's inbox,
+// according to the DSN parameters given for the message and each recipient.
+// The message may only be sent from the addresses allowed by the scope of the credentials used.
+func (user *User) SendMail(authID string, scope vault.AppPasswordScope, from string, to []string, dsn DSN, r io.Reader) error {
 	if len(to) == 0 {
 		return ErrInvalidRecipient
 	}
 
-	if err := user.sendMail(authID, from, to, r); err != nil {
+	// Write the message to disk rather than holding it in memory, as it may be large.
+	literal, err := newSpooledLiteral(r, int64(user.GetSendLimit()))
+	if err != nil {
+		return fmt.Errorf("failed to read message: %w", err)
+	}
+
+	defer func() {
+		if err := literal.Close(); err != nil {
+			user.log.WithError(err).Error("Failed to remove spooled message")
+		}
+	}()
+
+	err = user.sendMail(authID, scope, from, to, literal)
+
+	// Nothing was sent if the API could not be reached, so there are no API events to wait for.
+	if isTransientSendError(err) {
+		b, readErr := readLiteral(literal)
+		if readErr != nil {
+			return fmt.Errorf("failed to read message: %w", readErr)
+		}
+
+		return user.queueMail(authID, from, to, dsn, b, err)
+	}
+
+	if user.vault.SyncStatus().IsComplete() {
+		defer user.goPollAPIEvents(true)
+	}
+
+	if err != nil {
 		if apiErr := new(proton.APIError); errors.As(err, &apiErr) {
 			logrus.WithError(apiErr).WithField("Details", apiErr.DetailsToString()).Error("failed to send message")
 		}
 
+		// If the return path is not one of the user's addresses, there is no inbox to report the failure to.
+		// Messages from addresses the credentials can't send from are rejected outright as well.
+		if !errors.Is(err, ErrInvalidReturnPath) && !errors.Is(err, ErrSenderNotAllowed) {
+			user.reportDeliveryFailure(from, to, dsn, literal, err)
+		}
+
 		return err
 	}
 
 	return nil
 }
 
+// GetSendLimit returns the size of the largest message the user can send, as sent messages are stored in the
+// user's account. It returns -1 if the user's space is not limited.
+func (user *User) GetSendLimit() int {
+	return safe.RLockRet(func() int {
+		if user.apiUser.MaxSpace == 0 {
+			return -1
+		}
+
+		if user.apiUser.UsedSpace >= user.apiUser.MaxSpace {
+			return 0
+		}
+
+		return user.apiUser.MaxSpace - user.apiUser.UsedSpace
+	}, user.apiUserLock)
+}
+
 // CheckAuth returns whether the given email and password can be used to authenticate over IMAP or SMTP with this user.
-// It returns the address ID of the authenticated address.
-func (user *User) CheckAuth(email string, password []byte) (string, error) {
+// It returns the address ID of the authenticated address, and the scope of the password used;
+// the bridge password isn't restricted in any way.
+func (user *User) CheckAuth(email string, password []byte) (string, vault.AppPasswordScope, error) {
 	user.log.WithField("email", logging.Sensitive(email)).Debug("Checking authentication")
 
 	if email == "crash@bandicoot" {
@@ -511,16 +612,66 @@ func (user *User) CheckAuth(email string, password []byte) (string, error) {
 
 	dec, err := algo.B64RawDecode(password)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode password: %w", err)
+		return "", vault.AppPasswordScope{}, fmt.Errorf("failed to decode password: %w", err)
 	}
 
-	if subtle.ConstantTimeCompare(user.vault.BridgePass(), dec) != 1 {
+	appPass, isAppPass := user.vault.CheckAppPassword(dec)
+
+	if subtle.ConstantTimeCompare(user.vault.BridgePass(), dec) != 1 && !isAppPass {
 		err := fmt.Errorf("invalid password")
 		user.ReportConfigStatusFailure(err.Error())
-		return "", err
+		return "", vault.AppPasswordScope{}, err
+	}
+
+	return user.authenticate(email, appPass, isAppPass)
+}
+
+// GetSCRAMCredentials returns the credentials with which clients can authenticate with this user using SCRAM,
+// along with the ID of the app password of each verifier, empty for the bridge password.
+func (user *User) GetSCRAMCredentials() (scram.Credentials, []string, error) {
+	salt, credentials, err := user.vault.GetSCRAMCredentials()
+	if err != nil {
+		return scram.Credentials{}, nil, fmt.Errorf("failed to get SCRAM credentials: %w", err)
+	}
+
+	return scram.Credentials{
+		Salt:       salt,
+		Iterations: scram.Iterations,
+		Verifiers:  xslices.Map(credentials, func(c vault.SCRAMCredential) scram.Verifier { return c.Verifier }),
+	}, xslices.Map(credentials, func(c vault.SCRAMCredential) string { return c.AppPasswordID }), nil
+}
+
+// CheckSCRAMAuth is like CheckAuth, for clients which have proved with SCRAM that they know the password
+// of the app password with the given ID, or the bridge password if empty.
+func (user *User) CheckSCRAMAuth(email string, appPassID string) (string, vault.AppPasswordScope, error) {
+	if appPassID == "" {
+		return user.authenticate(email, vault.AppPassword{}, false)
+	}
+
+	appPasswords := user.vault.GetAppPasswords()
+
+	idx := xslices.IndexFunc(appPasswords, func(appPass vault.AppPassword) bool { return appPass.ID == appPassID })
+	if idx < 0 {
+		return "", vault.AppPasswordScope{}, vault.ErrNoSuchAppPassword
+	}
+
+	return user.authenticate(email, appPasswords[idx], true)
+}
+
+// CheckExternalAuth is like CheckAuth, for clients which have presented a TLS client certificate mapped to this user
+// which can be used instead of a password. They are granted the same access as with the bridge password.
+func (user *User) CheckExternalAuth(email string) (string, vault.AppPasswordScope, error) {
+	return user.authenticate(email, vault.AppPassword{}, false)
+}
+
+// authenticate returns the address ID of the given email and the scope of the password used,
+// once the client has proved that it knows the bridge password or the given app password.
+func (user *User) authenticate(email string, appPass vault.AppPassword, isAppPass bool) (string, vault.AppPasswordScope, error) {
+	if !appPass.Scope.HasAddress(email) {
+		return "", vault.AppPasswordScope{}, fmt.Errorf("the password can't be used with this address")
 	}
 
-	return safe.RLockRetErr(func() (string, error) {
+	addrID, err := safe.RLockRetErr(func() (string, error) {
 		for _, addr := range user.apiAddrs {
 			if addr.Status != proton.AddressStatusEnabled {
 				continue
@@ -533,6 +684,18 @@ func (user *User) CheckAuth(email string, password []byte) (string, error) {
 
 		return "", fmt.Errorf("invalid email")
 	}, user.apiAddrsLock)
+	if err != nil {
+		return "", vault.AppPasswordScope{}, err
+	}
+
+	// Clients authenticate often; only record the last use of an app password once in a while.
+	if isAppPass && time.Since(appPass.LastUsedAt) >= appPasswordLastUsedResolution {
+		if err := user.vault.SetAppPasswordLastUsed(appPass.ID, time.Now()); err != nil {
+			user.log.WithError(err).Warn("Failed to record app password use")
+		}
+	}
+
+	return addrID, appPass.Scope, nil
 }
 
 // OnStatusUp is called when the connection goes up.
@@ -540,6 +703,7 @@ func (user *User) OnStatusUp(context.Context) {
 	user.log.Info("Connection is up")
 
 	user.goSync()
+	user.goDrainOutbox()
 }
 
 // OnStatusDown is called when the connection goes down.
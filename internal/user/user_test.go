@@ -149,7 +149,7 @@ func withUser(tb testing.TB, ctx context.Context, _ *server.Server, m *proton.Ma
 	defer ctl.Finish()
 	manager := mocks.NewMockHeartbeatManager(ctl)
 	manager.EXPECT().IsTelemetryAvailable(context.Background()).AnyTimes()
-	user, err := New(ctx, vaultUser, client, nil, apiUser, nil, true, vault.DefaultMaxSyncMemory, tb.TempDir(), manager)
+	user, err := New(ctx, vaultUser, client, nil, apiUser, nil, true, vault.DefaultMaxSyncMemory, tb.TempDir(), manager, m, nil)
 	require.NoError(tb, err)
 	defer user.Close()
 
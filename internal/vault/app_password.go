@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/google/uuid"
+)
+
+// appPasswordLength is the length, in bytes, of the raw tokens used as app passwords.
+const appPasswordLength = 16
+
+var (
+	// ErrNoSuchAppPassword is returned when a user has no app password with the given ID.
+	ErrNoSuchAppPassword = errors.New("no such app password")
+
+	// ErrAppPasswordExists is returned when a user already has an app password with the given name.
+	ErrAppPasswordExists = errors.New("an app password with this name already exists")
+)
+
+// GetAppPasswords returns the user's app passwords, oldest first.
+func (user *User) GetAppPasswords() []AppPassword {
+	return getUserValue(user.vault, user.userID, func(data UserData) []AppPassword {
+		return xslices.Map(data.AppPasswords, AppPassword.clone)
+	})
+}
+
+// AddAppPassword creates a new app password with the given name and scope.
+// It returns the app password along with the password itself as raw token bytes (unencoded),
+// which can't be retrieved afterwards.
+func (user *User) AddAppPassword(name string, scope AppPasswordScope) (AppPassword, []byte, error) {
+	if name == "" {
+		return AppPassword{}, nil, errors.New("the app password name is empty")
+	}
+
+	password := newRandomToken(appPasswordLength)
+
+	appPass := AppPassword{
+		ID:        uuid.NewString(),
+		Name:      name,
+		Hash:      hashAppPassword(password),
+		Scope:     scope,
+		CreatedAt: time.Now(),
+	}
+
+	var err error
+
+	if modErr := user.vault.modUser(user.userID, func(data *UserData) {
+		if xslices.IndexFunc(data.AppPasswords, func(other AppPassword) bool { return other.Name == name }) >= 0 {
+			err = ErrAppPasswordExists
+		} else {
+			ensureSCRAMSalt(data)
+			appPass.Verifier = newSCRAMVerifier(password, data.SCRAMSalt)
+			data.AppPasswords = append(data.AppPasswords, appPass)
+		}
+	}); modErr != nil {
+		return AppPassword{}, nil, modErr
+	}
+
+	if err != nil {
+		return AppPassword{}, nil, err
+	}
+
+	return appPass, password, nil
+}
+
+// RemoveAppPassword revokes the app password with the given ID.
+func (user *User) RemoveAppPassword(id string) error {
+	var err error
+
+	if modErr := user.vault.modUser(user.userID, func(data *UserData) {
+		if idx := xslices.IndexFunc(data.AppPasswords, func(appPass AppPassword) bool { return appPass.ID == id }); idx < 0 {
+			err = ErrNoSuchAppPassword
+		} else {
+			data.AppPasswords = append(data.AppPasswords[:idx], data.AppPasswords[idx+1:]...)
+		}
+	}); modErr != nil {
+		return modErr
+	}
+
+	return err
+}
+
+// CheckAppPassword returns the app password matching the given password, given as raw token bytes (unencoded).
+// Every app password is compared, in constant time.
+func (user *User) CheckAppPassword(password []byte) (AppPassword, bool) {
+	var (
+		hash  = hashAppPassword(password)
+		match AppPassword
+		found bool
+	)
+
+	for _, appPass := range user.GetAppPasswords() {
+		if subtle.ConstantTimeCompare(appPass.Hash, hash) == 1 {
+			match, found = appPass, true
+		}
+	}
+
+	return match, found
+}
+
+// SetAppPasswordLastUsed records the time at which the app password with the given ID was last used.
+func (user *User) SetAppPasswordLastUsed(id string, lastUsed time.Time) error {
+	var err error
+
+	if modErr := user.vault.modUser(user.userID, func(data *UserData) {
+		if idx := xslices.IndexFunc(data.AppPasswords, func(appPass AppPassword) bool { return appPass.ID == id }); idx < 0 {
+			err = ErrNoSuchAppPassword
+		} else {
+			data.AppPasswords[idx].LastUsedAt = lastUsed
+		}
+	}); modErr != nil {
+		return modErr
+	}
+
+	return err
+}
+
+func hashAppPassword(password []byte) []byte {
+	hash := sha256.Sum256(password)
+
+	return hash[:]
+}
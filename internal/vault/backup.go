@@ -0,0 +1,207 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// backupCount is how many previous generations of the vault file are kept, to recover from a bad write.
+const backupCount = 3
+
+// backupInterval is how often the vault file is backed up while it is open; it is also backed up when it is opened.
+// Backing it up with every write would fill the backups with close versions, all affected by a recent mistake.
+const backupInterval = 24 * time.Hour
+
+var ErrNoSuchGeneration = errors.New("no such vault generation")
+
+// Generation is a version of the vault file: the current one, or one of the previous ones kept as backups.
+type Generation struct {
+	// Index is 0 for the current vault file, 1 for the previous one, and so on.
+	Index int
+
+	// ModTime is when the generation was written.
+	ModTime time.Time
+
+	// UserCount is the number of users in the generation.
+	UserCount int
+
+	// Err is the reason why the generation can't be read, e.g. as it is corrupt.
+	Err error
+}
+
+// ListGenerations returns the generations of the vault in the given directory, most recent first.
+func ListGenerations(vaultDir string, key []byte) ([]Generation, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var generations []Generation
+
+	for idx := 0; idx <= backupCount; idx++ {
+		path := generationPath(filepath.Join(vaultDir, vaultFileName), idx)
+
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		generation := Generation{
+			Index:   idx,
+			ModTime: info.ModTime(),
+		}
+
+		var data Data
+
+		if err := readVault(path, gcm, &data); err != nil {
+			generation.Err = err
+		} else {
+			generation.UserCount = len(data.Users)
+		}
+
+		generations = append(generations, generation)
+	}
+
+	return generations, nil
+}
+
+// RestoreGeneration replaces the vault in the given directory with the given previous generation.
+// The current vault is kept as the most recent backup, so that it can be restored in turn, unless it can't be read.
+// The vault must not be in use.
+func RestoreGeneration(vaultDir string, key []byte, idx int) error {
+	if idx < 1 || idx > backupCount {
+		return ErrNoSuchGeneration
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(vaultDir, vaultFileName)
+
+	var data Data
+
+	if err := readVault(generationPath(path, idx), gcm, &data); errors.Is(err, fs.ErrNotExist) {
+		return ErrNoSuchGeneration
+	} else if err != nil {
+		return fmt.Errorf("failed to read vault generation %d: %w", idx, err)
+	}
+
+	if err := backupVault(path, gcm); err != nil {
+		return fmt.Errorf("failed to back up vault: %w", err)
+	}
+
+	return writeVault(path, gcm, data)
+}
+
+// readGenerations reads the most recent generation of the vault at the given path which can be read, and returns its index.
+// It returns fs.ErrNotExist if there is none.
+func readGenerations(path string, gcm cipher.AEAD, data *Data) (int, error) {
+	var errs []error
+
+	for idx := 0; idx <= backupCount; idx++ {
+		var generation Data
+
+		if err := readVault(generationPath(path, idx), gcm, &generation); err == nil {
+			*data = generation
+			return idx, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("generation %d: %w", idx, err))
+		}
+	}
+
+	if len(errs) == 0 {
+		return 0, fs.ErrNotExist
+	}
+
+	return 0, errors.Join(errs...)
+}
+
+// backupVault keeps a copy of the vault file at the given path as the most recent backup.
+// A file which can't be read is not kept, and backups which can't be read are dropped before good ones,
+// so that a corrupt generation never takes the place of a good one.
+func backupVault(path string, gcm cipher.AEAD) error {
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := unmarshalFile(gcm, enc, new(Data)); err != nil {
+		return nil //nolint:nilerr
+	}
+
+	// The backups are shifted up to the first one which is missing or can't be read, or the oldest one, which is dropped.
+	last := 1
+
+	for last < backupCount && readVault(generationPath(path, last), gcm, new(Data)) == nil {
+		last++
+	}
+
+	for idx := last - 1; idx > 0; idx-- {
+		if err := os.Rename(generationPath(path, idx), generationPath(path, idx+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return writeFileAtomic(generationPath(path, 1), enc)
+}
+
+// moveGenerationsAside renames the generations of the vault at the given path, none of which can be read,
+// so that they are kept rather than overwritten, e.g. if they were encrypted with another key.
+// They are suffixed with the current time, e.g. vault.enc.corrupt-20230901T120000 and vault.enc.1.corrupt-20230901T120000.
+func moveGenerationsAside(path string) error {
+	suffix := ".corrupt-" + time.Now().Format("20060102T150405")
+
+	for idx := 0; idx <= backupCount; idx++ {
+		if err := os.Rename(generationPath(path, idx), generationPath(path, idx)+suffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readVault(path string, gcm cipher.AEAD, data *Data) error {
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+
+	return unmarshalFile(gcm, enc, data)
+}
+
+// generationPath returns the path of the given generation of the vault file at the given path.
+func generationPath(path string, idx int) string {
+	if idx == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%v.%d", path, idx)
+}
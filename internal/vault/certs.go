@@ -18,12 +18,15 @@
 package vault
 
 import (
+	"bytes"
 	"crypto/tls"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 // GetBridgeTLSCert returns the PEM-encoded certificate for the bridge.
@@ -33,7 +36,7 @@ func (vault *Vault) GetBridgeTLSCert() ([]byte, []byte) {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
 
-	certs := vault.getUnsafe().Certs
+	certs := vault.data.Certs
 
 	if certPath, keyPath := certs.CustomCertPath, certs.CustomKeyPath; certPath != "" && keyPath != "" {
 		if certPEM, keyPEM, err := readPEMCert(certPath, keyPath); err == nil {
@@ -43,7 +46,14 @@ func (vault *Vault) GetBridgeTLSCert() ([]byte, []byte) {
 		logrus.Error("Failed to read certificate from file, using default")
 	}
 
-	return certs.Bridge.Cert, certs.Bridge.Key
+	return bytes.Clone(certs.Bridge.Cert), bytes.Clone(certs.Bridge.Key)
+}
+
+// GetBridgeTLSCertPath returns the paths to the PEM-encoded certificate and key files for the bridge, if set.
+func (vault *Vault) GetBridgeTLSCertPath() (string, string) {
+	certs := vault.getCerts()
+
+	return certs.CustomCertPath, certs.CustomKeyPath
 }
 
 // SetBridgeTLSCertPath sets the path to PEM-encoded certificates for the bridge.
@@ -58,16 +68,63 @@ func (vault *Vault) SetBridgeTLSCertPath(certPath, keyPath string) error {
 	})
 }
 
+// ClearBridgeTLSCertPath stops reading the certificate for the bridge from files, to use the one in the vault.
+func (vault *Vault) ClearBridgeTLSCertPath() error {
+	return vault.modSafe(func(data *Data) {
+		data.Certs.CustomCertPath = ""
+		data.Certs.CustomKeyPath = ""
+	})
+}
+
+// GetBridgeTLSCertHosts returns the hostnames and IP addresses the generated certificate for the bridge is valid for.
+func (vault *Vault) GetBridgeTLSCertHosts() []string {
+	if hosts := vault.getCerts().Hosts; len(hosts) > 0 {
+		return slices.Clone(hosts)
+	}
+
+	return []string{certs.DefaultHost}
+}
+
+// GetBridgeTLSKeyType returns the type of the private key of the generated certificate for the bridge.
+func (vault *Vault) GetBridgeTLSKeyType() certs.KeyType {
+	return vault.getCerts().KeyType
+}
+
+// SetBridgeTLSCertOptions sets the hostnames and IP addresses and the key type of the generated certificate for the bridge.
+// The certificate itself is set with SetBridgeTLSCertKey.
+func (vault *Vault) SetBridgeTLSCertOptions(hosts []string, keyType certs.KeyType) error {
+	return vault.modSafe(func(data *Data) {
+		data.Certs.Hosts = slices.Clone(hosts)
+		data.Certs.KeyType = keyType
+	})
+}
+
 // SetBridgeTLSCertKey sets the path to PEM-encoded certificates for the bridge.
 func (vault *Vault) SetBridgeTLSCertKey(cert, key []byte) error {
 	return vault.modSafe(func(data *Data) {
-		data.Certs.Bridge.Cert = cert
-		data.Certs.Bridge.Key = key
+		data.Certs.Bridge.Cert = bytes.Clone(cert)
+		data.Certs.Bridge.Key = bytes.Clone(key)
+	})
+}
+
+// GetClientCA returns the PEM-encoded certificate and key of the CA issuing the client certificates.
+// They are empty if the vault was created before client certificates were issued by a CA of their own.
+func (vault *Vault) GetClientCA() ([]byte, []byte) {
+	certs := vault.getCerts()
+
+	return bytes.Clone(certs.ClientCA.Cert), bytes.Clone(certs.ClientCA.Key)
+}
+
+// SetClientCA sets the PEM-encoded certificate and key of the CA issuing the client certificates.
+func (vault *Vault) SetClientCA(cert, key []byte) error {
+	return vault.modSafe(func(data *Data) {
+		data.Certs.ClientCA.Cert = bytes.Clone(cert)
+		data.Certs.ClientCA.Key = bytes.Clone(key)
 	})
 }
 
 func (vault *Vault) GetCertsInstalled() bool {
-	return vault.getSafe().Certs.Installed
+	return vault.getCerts().Installed
 }
 
 func (vault *Vault) SetCertsInstalled(installed bool) error {
@@ -20,6 +20,7 @@ package vault_test
 import (
 	"testing"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
 	"github.com/stretchr/testify/require"
 )
 
@@ -41,3 +42,42 @@ func TestVault_TLSCerts(t *testing.T) {
 	// Check the certificates are installed.
 	require.True(t, s.GetCertsInstalled())
 }
+
+func TestVault_TLSCertOptions(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// By default, the certificate is for the default host, with an RSA key.
+	require.Equal(t, []string{certs.DefaultHost}, s.GetBridgeTLSCertHosts())
+	require.Equal(t, certs.KeyTypeRSA, s.GetBridgeTLSKeyType())
+
+	// Change the options.
+	require.NoError(t, s.SetBridgeTLSCertOptions([]string{"bridge.local", "::1"}, certs.KeyTypeEd25519))
+
+	// Check the new options.
+	require.Equal(t, []string{"bridge.local", "::1"}, s.GetBridgeTLSCertHosts())
+	require.Equal(t, certs.KeyTypeEd25519, s.GetBridgeTLSKeyType())
+}
+
+func TestVault_ClientCA(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// A new vault has a client CA, distinct from the TLS certificate.
+	caCert, caKey := s.GetClientCA()
+	require.NotEmpty(t, caCert)
+	require.NotEmpty(t, caKey)
+
+	tlsCert, _ := s.GetBridgeTLSCert()
+	require.NotEqual(t, tlsCert, caCert)
+
+	// It can issue client certificates.
+	_, _, err := certs.NewClientCert(caCert, caKey, "laptop")
+	require.NoError(t, err)
+
+	// Replacing the TLS certificate keeps the client CA.
+	require.NoError(t, s.SetBridgeTLSCertKey([]byte("cert"), []byte("key")))
+
+	newCACert, _ := s.GetClientCA()
+	require.Equal(t, caCert, newCACert)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"errors"
+	"time"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// ErrNoSuchClientCert is returned when a user has no client certificate with the given ID.
+	ErrNoSuchClientCert = errors.New("no such client certificate")
+
+	// ErrClientCertExists is returned when a user already has a client certificate with the given name or fingerprint.
+	ErrClientCertExists = errors.New("a client certificate with this name or fingerprint already exists")
+)
+
+// GetClientCerts returns the client certificates mapped to the user, oldest first.
+func (user *User) GetClientCerts() []ClientCert {
+	return getUserValue(user.vault, user.userID, func(data UserData) []ClientCert {
+		return slices.Clone(data.ClientCerts)
+	})
+}
+
+// AddClientCert maps a client certificate, given by its fingerprint or its subject, to the user.
+func (user *User) AddClientCert(name, fingerprint, subject string, skipPassword bool) (ClientCert, error) {
+	if name == "" {
+		return ClientCert{}, errors.New("the client certificate name is empty")
+	}
+
+	if fingerprint == "" && subject == "" {
+		return ClientCert{}, errors.New("either the fingerprint or the subject of the client certificate is needed")
+	}
+
+	cert := ClientCert{
+		ID:           uuid.NewString(),
+		Name:         name,
+		Fingerprint:  fingerprint,
+		Subject:      subject,
+		SkipPassword: skipPassword,
+		CreatedAt:    time.Now(),
+	}
+
+	var err error
+
+	if modErr := user.vault.modUser(user.userID, func(data *UserData) {
+		if xslices.IndexFunc(data.ClientCerts, func(other ClientCert) bool {
+			return other.Name == name || (fingerprint != "" && other.Fingerprint == fingerprint)
+		}) >= 0 {
+			err = ErrClientCertExists
+		} else {
+			data.ClientCerts = append(data.ClientCerts, cert)
+		}
+	}); modErr != nil {
+		return ClientCert{}, modErr
+	}
+
+	if err != nil {
+		return ClientCert{}, err
+	}
+
+	return cert, nil
+}
+
+// RemoveClientCert removes the client certificate with the given ID.
+func (user *User) RemoveClientCert(id string) error {
+	var err error
+
+	if modErr := user.vault.modUser(user.userID, func(data *UserData) {
+		if idx := xslices.IndexFunc(data.ClientCerts, func(cert ClientCert) bool { return cert.ID == id }); idx < 0 {
+			err = ErrNoSuchClientCert
+		} else {
+			data.ClientCerts = append(data.ClientCerts[:idx], data.ClientCerts[idx+1:]...)
+		}
+	}); modErr != nil {
+		return modErr
+	}
+
+	return err
+}
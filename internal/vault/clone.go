@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"bytes"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+	"golang.org/x/exp/slices"
+)
+
+// The vault data is shared with its readers without copying it as a whole;
+// the helpers below copy the parts the getters return which the caller could change.
+
+func (appPass AppPassword) clone() AppPassword {
+	appPass.Hash = bytes.Clone(appPass.Hash)
+	appPass.Scope.Addresses = slices.Clone(appPass.Scope.Addresses)
+	appPass.Verifier = cloneVerifier(appPass.Verifier)
+
+	return appPass
+}
+
+func (status SyncStatus) clone() SyncStatus {
+	status.FailedMessageIDs = slices.Clone(status.FailedMessageIDs)
+
+	return status
+}
+
+func cloneVerifier(verifier scram.Verifier) scram.Verifier {
+	return scram.Verifier{
+		StoredKey: bytes.Clone(verifier.StoredKey),
+		ServerKey: bytes.Clone(verifier.ServerKey),
+	}
+}
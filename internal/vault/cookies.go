@@ -17,12 +17,17 @@
 
 package vault
 
+import "bytes"
+
 func (vault *Vault) GetCookies() ([]byte, error) {
-	return vault.getSafe().Cookies, nil
+	vault.lock.RLock()
+	defer vault.lock.RUnlock()
+
+	return bytes.Clone(vault.data.Cookies), nil
 }
 
 func (vault *Vault) SetCookies(cookies []byte) error {
 	return vault.modSafe(func(data *Data) {
-		data.Cookies = cookies
+		data.Cookies = bytes.Clone(cookies)
 	})
 }
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// ReadVersion returns the version of the vault file in the given directory, which is upgraded when it is next written.
+func ReadVersion(vaultDir string) (Version, error) {
+	b, err := os.ReadFile(filepath.Join(vaultDir, vaultFileName))
+	if err != nil {
+		return 0, err
+	}
+
+	var f File
+
+	if err := msgpack.Unmarshal(b, &f); err != nil {
+		return 0, err
+	}
+
+	return f.Version, nil
+}
+
+// Export returns the vault data as JSON. Unless secrets are requested, the keys, passwords, tokens and cookies are left out.
+func (vault *Vault) Export(secrets bool) ([]byte, error) {
+	vault.lock.RLock()
+	enc, err := json.Marshal(vault.data)
+	vault.lock.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var data Data
+
+	if err := json.Unmarshal(enc, &data); err != nil {
+		return nil, err
+	}
+
+	if !secrets {
+		redactData(&data)
+	}
+
+	return json.MarshalIndent(data, "", "  ")
+}
+
+// redactData removes the secrets from the given vault data.
+func redactData(data *Data) {
+	data.Settings.PasswordArchive = PasswordArchive{}
+	data.Cookies = nil
+	data.Certs.Bridge.Key = nil
+
+	for idx := range data.Users {
+		user := &data.Users[idx]
+
+		user.GluonKey = nil
+		user.PendingGluonKey = nil
+		user.BridgePass = nil
+		user.SCRAMSalt = nil
+		user.BridgePassVerifier = scram.Verifier{}
+		user.AuthUID = ""
+		user.AuthRef = ""
+		user.KeyPass = nil
+
+		for idx := range user.AppPasswords {
+			user.AppPasswords[idx].Hash = nil
+			user.AppPasswords[idx].Verifier = scram.Verifier{}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault_Export(t *testing.T) {
+	s := newVault(t)
+
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.SetEventID("eventID"))
+
+	_, appPass, err := user.AddAppPassword("laptop", vault.AppPasswordScope{})
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetCookies([]byte("cookies")))
+
+	secrets := [][]byte{
+		user.GluonKey(),
+		user.BridgePass(),
+		user.KeyPass(),
+		[]byte(user.AuthUID()),
+		[]byte(user.AuthRef()),
+		appPass,
+		[]byte("cookies"),
+	}
+
+	_, key := s.GetBridgeTLSCert()
+
+	secrets = append(secrets, key)
+
+	// The secrets are left out by default.
+	b, err := s.Export(false)
+	require.NoError(t, err)
+
+	for _, secret := range secrets {
+		require.NotContains(t, string(b), string(secret))
+		require.NotContains(t, string(b), base64.StdEncoding.EncodeToString(secret))
+	}
+
+	var data vault.Data
+
+	require.NoError(t, json.Unmarshal(b, &data))
+	require.Len(t, data.Users, 1)
+	require.Equal(t, "username", data.Users[0].Username)
+	require.Equal(t, "eventID", data.Users[0].EventID)
+	require.Len(t, data.Users[0].AppPasswords, 1)
+	require.Equal(t, "laptop", data.Users[0].AppPasswords[0].Name)
+	require.Empty(t, data.Users[0].AppPasswords[0].Hash)
+
+	// They are included if requested, and the vault is left unchanged.
+	b, err = s.Export(true)
+	require.NoError(t, err)
+
+	require.NoError(t, json.Unmarshal(b, &data))
+	require.Equal(t, user.GluonKey(), data.Users[0].GluonKey)
+	require.Equal(t, user.KeyPass(), data.Users[0].KeyPass)
+	require.Equal(t, "authUID", data.Users[0].AuthUID)
+	require.Equal(t, []byte("cookies"), data.Cookies)
+	require.Equal(t, key, data.Certs.Bridge.Key)
+}
+
+func TestVault_ReadVersion(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	s, _, err := vault.New(vaultDir, t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, s.SetIMAPPort(1234))
+	require.NoError(t, s.Close())
+
+	version, err := vault.ReadVersion(vaultDir)
+	require.NoError(t, err)
+	require.Equal(t, vault.Current, version)
+	require.Equal(t, "2.5.x", version.String())
+}
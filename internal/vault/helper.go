@@ -18,6 +18,7 @@
 package vault
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -28,10 +29,20 @@ import (
 
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/slices"
 )
 
-const vaultSecretName = "bridge-vault-key"
+const (
+	vaultSecretName = "bridge-vault-key"
+
+	// vaultNextSecretName holds the new vault key while it replaces the current one, as some keychains remove a secret
+	// before adding it again. If the current key is then lost, the new one is used instead.
+	vaultNextSecretName = "bridge-vault-key-next"
+)
+
+// ErrNoVaultKey is returned when the vault key is not found in the keychain, e.g. the one it should be moved from.
+var ErrNoVaultKey = errors.New("the vault key is not in the keychain")
 
 type Keychain struct {
 	Helper string
@@ -75,11 +86,35 @@ func HasVaultKey(kc *keychain.Keychain) (bool, error) {
 		return false, fmt.Errorf("could not list keychain: %w", err)
 	}
 
-	return slices.Contains(secrets, vaultSecretName), nil
+	return slices.Contains(secrets, vaultSecretName) || slices.Contains(secrets, vaultNextSecretName), nil
 }
 
 func GetVaultKey(kc *keychain.Keychain) ([]byte, error) {
-	_, keyEnc, err := kc.Get(vaultSecretName)
+	secrets, err := kc.List()
+	if err != nil {
+		return nil, fmt.Errorf("could not list keychain: %w", err)
+	}
+
+	if !slices.Contains(secrets, vaultSecretName) && slices.Contains(secrets, vaultNextSecretName) {
+		logrus.Warn("The vault key was lost while being replaced, using the new one")
+
+		key, err := getVaultKey(kc, vaultNextSecretName)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := SetVaultKey(kc, key); err != nil {
+			return nil, fmt.Errorf("could not put keychain item: %w", err)
+		}
+
+		return key, nil
+	}
+
+	return getVaultKey(kc, vaultSecretName)
+}
+
+func getVaultKey(kc *keychain.Keychain, name string) ([]byte, error) {
+	_, keyEnc, err := kc.Get(name)
 	if err != nil {
 		return nil, fmt.Errorf("could not get keychain item: %w", err)
 	}
@@ -108,3 +143,135 @@ func NewVaultKey(kc *keychain.Keychain) ([]byte, error) {
 
 	return tok, nil
 }
+
+// RekeyVault re-encrypts the vault with a new key, which then replaces the current one in the keychain.
+func RekeyVault(kc *keychain.Keychain, vault *Vault) error {
+	oldKey, err := GetVaultKey(kc)
+	if err != nil {
+		return err
+	}
+
+	newKey, err := crypto.RandomToken(32)
+	if err != nil {
+		return fmt.Errorf("could not generate random token: %w", err)
+	}
+
+	if err := kc.Put(vaultNextSecretName, base64.StdEncoding.EncodeToString(newKey)); err != nil {
+		return fmt.Errorf("could not put keychain item: %w", err)
+	}
+
+	// The new key is kept only if the current one was lost, so that it is used next time.
+	defer func() {
+		if secrets, err := kc.List(); err != nil || !slices.Contains(secrets, vaultSecretName) {
+			return
+		}
+
+		if err := kc.Delete(vaultNextSecretName); err != nil {
+			logrus.WithError(err).Warn("Failed to delete new vault key from keychain")
+		}
+	}()
+
+	return vault.Rekey(newKey, func(key []byte) error {
+		if err := SetVaultKey(kc, key); err != nil {
+			// The current key may have been removed before the new one failed to be added.
+			if err := SetVaultKey(kc, oldKey); err != nil {
+				logrus.WithError(err).Error("Failed to restore vault key")
+			}
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// MigrateVaultKey moves the vault key from the old keychain to the new one, recorded as the helper to use from now on.
+// The key is written to the new keychain and read back before being removed from the old one.
+// If any step fails, both keychains and the recorded helper are restored as they were.
+func MigrateVaultKey(vaultDir string, oldKC, newKC *keychain.Keychain, newHelper string) (err error) {
+	oldHelper, err := GetHelper(vaultDir)
+	if err != nil {
+		return fmt.Errorf("could not get keychain helper: %w", err)
+	}
+
+	if has, err := HasVaultKey(oldKC); err != nil {
+		return fmt.Errorf("could not check for vault key: %w", err)
+	} else if !has {
+		return ErrNoVaultKey
+	}
+
+	key, err := GetVaultKey(oldKC)
+	if err != nil {
+		return err
+	}
+
+	// The new keychain may hold the key of a vault it was used for before, which is put back on failure.
+	prevKey, err := getPrevVaultKey(newKC)
+	if err != nil {
+		return err
+	}
+
+	var rollback []func() error
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		for idx := len(rollback) - 1; idx >= 0; idx-- {
+			if err := rollback[idx](); err != nil {
+				logrus.WithError(err).Error("Failed to roll back vault key migration")
+			}
+		}
+	}()
+
+	rollback = append(rollback, func() error {
+		if prevKey != nil {
+			return SetVaultKey(newKC, prevKey)
+		}
+
+		return newKC.Delete(vaultSecretName)
+	})
+
+	if err := SetVaultKey(newKC, key); err != nil {
+		return fmt.Errorf("could not put vault key in new keychain: %w", err)
+	}
+
+	if readKey, err := getVaultKey(newKC, vaultSecretName); err != nil {
+		return fmt.Errorf("could not read vault key back from new keychain: %w", err)
+	} else if !bytes.Equal(readKey, key) {
+		return errors.New("the vault key read back from the new keychain does not match")
+	}
+
+	rollback = append(rollback, func() error {
+		return SetHelper(vaultDir, oldHelper)
+	})
+
+	if err := SetHelper(vaultDir, newHelper); err != nil {
+		return fmt.Errorf("could not set keychain helper: %w", err)
+	}
+
+	rollback = append(rollback, func() error {
+		return SetVaultKey(oldKC, key)
+	})
+
+	if err := oldKC.Delete(vaultSecretName); err != nil {
+		return fmt.Errorf("could not remove vault key from old keychain: %w", err)
+	}
+
+	return nil
+}
+
+// getPrevVaultKey returns the vault key held by the keychain, if any.
+func getPrevVaultKey(kc *keychain.Keychain) ([]byte, error) {
+	secrets, err := kc.List()
+	if err != nil {
+		return nil, fmt.Errorf("could not list keychain: %w", err)
+	}
+
+	if !slices.Contains(secrets, vaultSecretName) {
+		return nil, nil
+	}
+
+	return getVaultKey(kc, vaultSecretName)
+}
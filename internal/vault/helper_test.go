@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	dockerCredentials "github.com/docker/docker-credential-helpers/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateVaultKey(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	oldKC, newKC := newMockKeychain(t, "old", nil), newMockKeychain(t, "new", nil)
+
+	require.NoError(t, vault.SetHelper(vaultDir, "old"))
+
+	key, err := vault.NewVaultKey(oldKC)
+	require.NoError(t, err)
+
+	require.NoError(t, vault.MigrateVaultKey(vaultDir, oldKC, newKC, "new"))
+
+	// The key was moved to the new keychain, which is now used.
+	helper, err := vault.GetHelper(vaultDir)
+	require.NoError(t, err)
+	require.Equal(t, "new", helper)
+
+	has, err := vault.HasVaultKey(oldKC)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	newKey, err := vault.GetVaultKey(newKC)
+	require.NoError(t, err)
+	require.Equal(t, key, newKey)
+}
+
+func TestMigrateVaultKey_NoKey(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	oldKC, newKC := newMockKeychain(t, "old", nil), newMockKeychain(t, "new", nil)
+
+	require.NoError(t, vault.SetHelper(vaultDir, "old"))
+	require.ErrorIs(t, vault.MigrateVaultKey(vaultDir, oldKC, newKC, "new"), vault.ErrNoVaultKey)
+
+	helper, err := vault.GetHelper(vaultDir)
+	require.NoError(t, err)
+	require.Equal(t, "old", helper)
+}
+
+func TestMigrateVaultKey_Rollback(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	// The key can't be removed from the old keychain.
+	oldKC := newMockKeychain(t, "old", &failingHelper{TestHelper: keychain.NewTestHelper(), failDelete: true})
+	newKC := newMockKeychain(t, "new", nil)
+
+	require.NoError(t, vault.SetHelper(vaultDir, "old"))
+
+	key, err := vault.NewVaultKey(oldKC)
+	require.NoError(t, err)
+
+	// The new keychain holds the key of another vault.
+	prevKey, err := vault.NewVaultKey(newKC)
+	require.NoError(t, err)
+
+	require.Error(t, vault.MigrateVaultKey(vaultDir, oldKC, newKC, "new"))
+
+	// Everything is as it was.
+	helper, err := vault.GetHelper(vaultDir)
+	require.NoError(t, err)
+	require.Equal(t, "old", helper)
+
+	oldKey, err := vault.GetVaultKey(oldKC)
+	require.NoError(t, err)
+	require.Equal(t, key, oldKey)
+
+	newKey, err := vault.GetVaultKey(newKC)
+	require.NoError(t, err)
+	require.Equal(t, prevKey, newKey)
+}
+
+func TestMigrateVaultKey_Mismatch(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	// The new keychain doesn't return what was written to it.
+	oldKC := newMockKeychain(t, "old", nil)
+	newKC := newMockKeychain(t, "new", &failingHelper{TestHelper: keychain.NewTestHelper(), corruptGet: true})
+
+	require.NoError(t, vault.SetHelper(vaultDir, "old"))
+
+	key, err := vault.NewVaultKey(oldKC)
+	require.NoError(t, err)
+
+	require.Error(t, vault.MigrateVaultKey(vaultDir, oldKC, newKC, "new"))
+
+	helper, err := vault.GetHelper(vaultDir)
+	require.NoError(t, err)
+	require.Equal(t, "old", helper)
+
+	oldKey, err := vault.GetVaultKey(oldKC)
+	require.NoError(t, err)
+	require.Equal(t, key, oldKey)
+
+	has, err := vault.HasVaultKey(newKC)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+// newMockKeychain registers the given keychain helper, or a new test helper, under the given name.
+func newMockKeychain(t *testing.T, name string, helper dockerCredentials.Helper) *keychain.Keychain {
+	if helper == nil {
+		helper = keychain.NewTestHelper()
+	}
+
+	keychain.Helpers[name] = func(string) (dockerCredentials.Helper, error) { return helper, nil }
+
+	t.Cleanup(func() { delete(keychain.Helpers, name) })
+
+	kc, err := keychain.NewKeychain(name, "bridge")
+	require.NoError(t, err)
+
+	return kc
+}
+
+type failingHelper struct {
+	keychain.TestHelper
+
+	failDelete bool
+	corruptGet bool
+}
+
+func (h *failingHelper) Delete(url string) error {
+	if h.failDelete {
+		return errors.New("failed to delete")
+	}
+
+	return h.TestHelper.Delete(url)
+}
+
+func (h *failingHelper) Get(url string) (string, string, error) {
+	username, secret, err := h.TestHelper.Get(url)
+	if h.corruptGet {
+		secret = "AAAA" + secret
+	}
+
+	return username, secret, err
+}
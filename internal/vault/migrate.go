@@ -29,6 +29,22 @@ const (
 	Current = v2_5_x
 )
 
+func (v Version) String() string {
+	switch v {
+	case v2_3_x:
+		return "2.3.x"
+
+	case v2_4_x:
+		return "2.4.x"
+
+	case v2_5_x:
+		return "2.5.x"
+
+	default:
+		return fmt.Sprintf("unknown (%d)", int(v))
+	}
+}
+
 // upgrade migrates the vault from the given version to the next version.
 func upgrade(v Version, b []byte) ([]byte, error) {
 	switch v {
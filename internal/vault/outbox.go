@@ -0,0 +1,238 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// outboxDirName is the name of the directory, next to the vault file, in which users' outboxes are stored.
+const outboxDirName = "outbox"
+
+// ErrNoSuchOutboxMessage is returned when a message is not in the user's outbox.
+var ErrNoSuchOutboxMessage = errors.New("no such outbox message")
+
+// GetOutbox returns the messages in the user's outbox, oldest first.
+func (user *User) GetOutbox() ([]OutboxMessage, error) {
+	unlock, err := user.vault.lockOutbox(false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	entries, err := os.ReadDir(user.outboxDir())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	messages := make([]OutboxMessage, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) == ".tmp" || filepath.Ext(entry.Name()) == rekeySuffix {
+			continue
+		}
+
+		message, err := user.readOutboxMessage(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].QueuedAt.Before(messages[j].QueuedAt)
+	})
+
+	return messages, nil
+}
+
+// GetOutboxMessage returns the message with the given ID from the user's outbox.
+func (user *User) GetOutboxMessage(messageID string) (OutboxMessage, error) {
+	unlock, err := user.vault.lockOutbox(false)
+	if err != nil {
+		return OutboxMessage{}, err
+	}
+	defer unlock()
+
+	return user.readOutboxMessage(messageID)
+}
+
+// PutOutboxMessage adds the given message to the user's outbox, replacing any message with the same ID.
+// The message is encrypted with the vault key and written atomically.
+func (user *User) PutOutboxMessage(message OutboxMessage) error {
+	unlock, err := user.vault.lockOutbox(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if message.ID == "" || message.ID != filepath.Base(message.ID) {
+		return fmt.Errorf("invalid outbox message ID %q", message.ID)
+	}
+
+	if err := os.MkdirAll(user.outboxDir(), 0o700); err != nil {
+		return fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	dec, err := msgpack.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	enc, err := sealOutboxMessage(user.vault.gcm, dec)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(user.outboxDir(), message.ID)
+
+	if err := os.WriteFile(path+".tmp", enc, 0o600); err != nil {
+		return fmt.Errorf("failed to write outbox message: %w", err)
+	}
+
+	// A copy re-encrypted with a new key which couldn't be stored would hold the message as it was.
+	if err := os.Remove(path + rekeySuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// RemoveOutboxMessage removes the message with the given ID from the user's outbox.
+func (user *User) RemoveOutboxMessage(messageID string) error {
+	unlock, err := user.vault.lockOutbox(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if messageID == "" || messageID != filepath.Base(messageID) {
+		return ErrNoSuchOutboxMessage
+	}
+
+	// A copy re-encrypted with a new key which couldn't be stored is removed too, lest the message be queued again.
+	if err := os.Remove(filepath.Join(user.outboxDir(), messageID+rekeySuffix)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove outbox message: %w", err)
+	}
+
+	if err := os.Remove(filepath.Join(user.outboxDir(), messageID)); errors.Is(err, fs.ErrNotExist) {
+		return ErrNoSuchOutboxMessage
+	} else if err != nil {
+		return fmt.Errorf("failed to remove outbox message: %w", err)
+	}
+
+	return nil
+}
+
+func (user *User) readOutboxMessage(messageID string) (OutboxMessage, error) {
+	if messageID == "" || messageID != filepath.Base(messageID) {
+		return OutboxMessage{}, ErrNoSuchOutboxMessage
+	}
+
+	enc, err := os.ReadFile(filepath.Join(user.outboxDir(), messageID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return OutboxMessage{}, ErrNoSuchOutboxMessage
+	} else if err != nil {
+		return OutboxMessage{}, fmt.Errorf("failed to read outbox message: %w", err)
+	}
+
+	dec, err := openOutboxMessage(user.vault.gcm, enc)
+	if err != nil {
+		return OutboxMessage{}, fmt.Errorf("failed to decrypt outbox message %s: %w", messageID, err)
+	}
+
+	var message OutboxMessage
+
+	if err := msgpack.Unmarshal(dec, &message); err != nil {
+		return OutboxMessage{}, fmt.Errorf("failed to unmarshal outbox message %s: %w", messageID, err)
+	}
+
+	return message, nil
+}
+
+// sealOutboxMessage encrypts the given encoded outbox message with the given cipher, prefixed with a random nonce.
+func sealOutboxMessage(gcm cipher.AEAD, dec []byte) ([]byte, error) {
+	nonce, err := crypto.RandomToken(gcm.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, dec, nil), nil
+}
+
+// openOutboxMessage decrypts the given outbox message, encrypted by sealOutboxMessage, with the given cipher.
+func openOutboxMessage(gcm cipher.AEAD, enc []byte) ([]byte, error) {
+	if len(enc) < gcm.NonceSize() {
+		return nil, errors.New("the message is corrupt")
+	}
+
+	return gcm.Open(nil, enc[:gcm.NonceSize()], enc[gcm.NonceSize():], nil)
+}
+
+// lockOutbox locks the outboxes. The vault is also locked for reading, as its key is used to encrypt the messages:
+// it can't change meanwhile, e.g. while the vault is re-encrypted with Rekey, nor be discarded when it is closed.
+// If write is true, it fails if the vault was opened read-only.
+func (vault *Vault) lockOutbox(write bool) (func(), error) {
+	vault.lock.RLock()
+
+	if vault.gcm == nil {
+		vault.lock.RUnlock()
+		return nil, errors.New("vault is closed")
+	}
+
+	if write && vault.readOnly {
+		vault.lock.RUnlock()
+		return nil, ErrReadOnly
+	}
+
+	vault.outboxLock.Lock()
+
+	return func() {
+		vault.outboxLock.Unlock()
+		vault.lock.RUnlock()
+	}, nil
+}
+
+func (user *User) outboxDir() string {
+	return user.vault.outboxDir(user.userID)
+}
+
+// outboxDir returns the directory in which the outbox of the given user is stored.
+func (vault *Vault) outboxDir(userID string) string {
+	return filepath.Join(filepath.Dir(vault.path), outboxDirName, userID)
+}
+
+// removeOutbox removes the outbox of the given user, along with any queued messages.
+func (vault *Vault) removeOutbox(userID string) error {
+	vault.outboxLock.Lock()
+	defer vault.outboxLock.Unlock()
+
+	return os.RemoveAll(vault.outboxDir(userID))
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser_Outbox(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// The outbox should initially be empty.
+	messages, err := user.GetOutbox()
+	require.NoError(t, err)
+	require.Empty(t, messages)
+
+	// Queue two messages, the second one older than the first.
+	require.NoError(t, user.PutOutboxMessage(vault.OutboxMessage{
+		ID:       "new",
+		From:     "username@pm.me",
+		To:       []string{"a@pm.me"},
+		Literal:  []byte("new message"),
+		QueuedAt: time.Now(),
+	}))
+
+	require.NoError(t, user.PutOutboxMessage(vault.OutboxMessage{
+		ID:       "old",
+		From:     "username@pm.me",
+		To:       []string{"b@pm.me"},
+		Literal:  []byte("old message"),
+		QueuedAt: time.Now().Add(-time.Hour),
+	}))
+
+	// The messages should be listed oldest first.
+	messages, err = user.GetOutbox()
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, "old", messages[0].ID)
+	require.Equal(t, "old message", string(messages[0].Literal))
+	require.Equal(t, "new", messages[1].ID)
+
+	// Update a message.
+	messages[0].Attempts++
+	messages[0].LastError = "failed"
+	require.NoError(t, user.PutOutboxMessage(messages[0]))
+
+	message, err := user.GetOutboxMessage("old")
+	require.NoError(t, err)
+	require.Equal(t, 1, message.Attempts)
+	require.Equal(t, "failed", message.LastError)
+
+	// Remove a message; removing it again should fail.
+	require.NoError(t, user.RemoveOutboxMessage("old"))
+	require.ErrorIs(t, user.RemoveOutboxMessage("old"), vault.ErrNoSuchOutboxMessage)
+
+	// IDs must not escape the outbox.
+	require.Error(t, user.PutOutboxMessage(vault.OutboxMessage{ID: "../escape"}))
+
+	// Deleting the user should remove its outbox.
+	require.NoError(t, user.Close())
+	require.NoError(t, s.DeleteUser("userID"))
+
+	user, err = s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	messages, err = user.GetOutbox()
+	require.NoError(t, err)
+	require.Empty(t, messages)
+}
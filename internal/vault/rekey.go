@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+)
+
+// rekeySuffix is appended to the path of the vault file re-encrypted with a new key, until the key is stored.
+const rekeySuffix = ".rekey"
+
+// Rekey re-encrypts the vault, the users' outboxes and the vault's backups with the given key.
+// The vault and outbox messages encrypted with the new key are written aside, then storeKey is called to store the key
+// before they are replaced. If the process stops in between, or the key can't be stored, they are replaced or the
+// re-encrypted ones discarded when the vault is next opened, depending on the key it is opened with.
+func (vault *Vault) Rekey(key []byte, storeKey func([]byte) error) error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if vault.gcm == nil {
+		return errors.New("vault is closed")
+	}
+
+	if vault.readOnly {
+		return ErrReadOnly
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	if err := vault.flushUnsafe(); err != nil {
+		return fmt.Errorf("failed to write vault: %w", err)
+	}
+
+	rekeyPath := vault.path + rekeySuffix
+
+	if err := writeVault(rekeyPath, gcm, vault.data); err != nil {
+		return fmt.Errorf("failed to write re-encrypted vault: %w", err)
+	}
+
+	if err := vault.writeRekeyedOutboxesUnsafe(gcm); err != nil {
+		return fmt.Errorf("failed to write re-encrypted outbox: %w", err)
+	}
+
+	// The re-encrypted vault is kept even if the key can't be stored, in case it was stored nonetheless.
+	if err := storeKey(key); err != nil {
+		return fmt.Errorf("failed to store vault key: %w", err)
+	}
+
+	oldGCM := vault.gcm
+
+	vault.gcm = gcm
+
+	if err := os.Rename(rekeyPath, vault.path); err != nil {
+		return fmt.Errorf("failed to replace vault: %w", err)
+	}
+
+	if err := completeOutboxRekey(filepath.Dir(vault.path), gcm); err != nil {
+		return fmt.Errorf("failed to replace outbox: %w", err)
+	}
+
+	vault.rekeyBackupsUnsafe(oldGCM)
+
+	return nil
+}
+
+// writeRekeyedOutboxesUnsafe writes the messages of the users' outboxes, re-encrypted with the given cipher, next to
+// the current ones. Messages which can't be decrypted are left as they are.
+func (vault *Vault) writeRekeyedOutboxesUnsafe(gcm cipher.AEAD) error {
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(vault.path), outboxDirName, "*", "*"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if ext := filepath.Ext(path); ext == ".tmp" || ext == rekeySuffix {
+			continue
+		}
+
+		enc, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+
+		dec, err := openOutboxMessage(vault.gcm, enc)
+		if err != nil {
+			logrus.WithError(err).WithField("path", path).Warn("Failed to decrypt outbox message, it won't be re-encrypted")
+			continue
+		}
+
+		if enc, err = sealOutboxMessage(gcm, dec); err != nil {
+			return err
+		}
+
+		if err := writeFileAtomic(path+rekeySuffix, enc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rekeyBackupsUnsafe re-encrypts the backups of the vault, encrypted with the given previous key, with the current one.
+// The users' gluon keys, which may have been rotated along with the vault key, are updated so that restoring a backup
+// keeps their gluon database readable.
+func (vault *Vault) rekeyBackupsUnsafe(oldGCM cipher.AEAD) {
+	for idx := 1; idx <= backupCount; idx++ {
+		path := generationPath(vault.path, idx)
+
+		var data Data
+
+		if err := readVault(path, oldGCM, &data); errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			logrus.WithError(err).WithField("generation", idx).Warn("Failed to read vault backup, it won't be re-encrypted")
+			continue
+		}
+
+		for i, user := range data.Users {
+			if cur := xslices.IndexFunc(vault.data.Users, func(cur UserData) bool { return cur.UserID == user.UserID }); cur >= 0 {
+				data.Users[i].GluonKey = vault.data.Users[cur].GluonKey
+			}
+		}
+
+		if err := writeVault(path, vault.gcm, data); err != nil {
+			logrus.WithError(err).WithField("generation", idx).Error("Failed to re-encrypt vault backup")
+		}
+	}
+}
+
+// completeRekey replaces the vault at the given path, and the outbox messages, with those re-encrypted with a new key,
+// if the process stopped after the key was stored. The re-encrypted ones are discarded if the key wasn't stored,
+// as it is then not the given one.
+func completeRekey(path string, gcm cipher.AEAD) error {
+	if err := completeVaultRekey(path, gcm); err != nil {
+		return err
+	}
+
+	return completeOutboxRekey(filepath.Dir(path), gcm)
+}
+
+func completeVaultRekey(path string, gcm cipher.AEAD) error {
+	rekeyPath := path + rekeySuffix
+
+	var data Data
+
+	if err := readVault(rekeyPath, gcm, &data); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		logrus.WithError(err).Warn("Discarding vault re-encrypted with a key which wasn't stored")
+
+		return os.Remove(rekeyPath)
+	}
+
+	logrus.Warn("Replacing vault with the one re-encrypted with the stored key")
+
+	return os.Rename(rekeyPath, path)
+}
+
+// completeOutboxRekey replaces the outbox messages in the given vault directory with those re-encrypted with a new key
+// which can be decrypted with the given cipher, and discards the others.
+func completeOutboxRekey(vaultDir string, gcm cipher.AEAD) error {
+	paths, err := filepath.Glob(filepath.Join(vaultDir, outboxDirName, "*", "*"+rekeySuffix))
+	if err != nil {
+		return err
+	}
+
+	for _, rekeyPath := range paths {
+		enc, err := os.ReadFile(filepath.Clean(rekeyPath))
+		if err != nil {
+			return err
+		}
+
+		if _, err := openOutboxMessage(gcm, enc); err != nil {
+			if err := os.Remove(rekeyPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := os.Rename(rekeyPath, strings.TrimSuffix(rekeyPath, rekeySuffix)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"bytes"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+)
+
+// SCRAMCredential is the SCRAM verifier of one of the user's passwords.
+type SCRAMCredential struct {
+	// AppPasswordID is the ID of the app password, or empty for the bridge password.
+	AppPasswordID string
+
+	Verifier scram.Verifier
+}
+
+// GetSCRAMCredentials returns the salt shared by the SCRAM verifiers of the user's passwords, and the verifiers.
+// Users added before SCRAM was supported get the salt, and the verifier of their bridge password, on first use.
+func (user *User) GetSCRAMCredentials() ([]byte, []SCRAMCredential, error) {
+	if len(user.vault.getUser(user.userID).SCRAMSalt) == 0 {
+		if err := user.vault.modUser(user.userID, ensureSCRAMSalt); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var salt []byte
+
+	credentials := getUserValue(user.vault, user.userID, func(data UserData) []SCRAMCredential {
+		salt = bytes.Clone(data.SCRAMSalt)
+
+		credentials := []SCRAMCredential{{Verifier: cloneVerifier(data.BridgePassVerifier)}}
+
+		for _, appPass := range data.AppPasswords {
+			if len(appPass.Verifier.StoredKey) > 0 {
+				credentials = append(credentials, SCRAMCredential{AppPasswordID: appPass.ID, Verifier: cloneVerifier(appPass.Verifier)})
+			}
+		}
+
+		return credentials
+	})
+
+	return salt, credentials, nil
+}
+
+// ensureSCRAMSalt generates the salt of the user's SCRAM verifiers if needed, along with the verifier of the bridge password.
+func ensureSCRAMSalt(data *UserData) {
+	if len(data.SCRAMSalt) > 0 {
+		return
+	}
+
+	data.SCRAMSalt = scram.NewSalt()
+	data.BridgePassVerifier = newSCRAMVerifier(data.BridgePass, data.SCRAMSalt)
+}
+
+// newSCRAMVerifier derives the SCRAM verifier of the given password, given as raw token bytes (unencoded).
+// Clients are given the encoded password, so that is what the verifier is derived from.
+func newSCRAMVerifier(password, salt []byte) scram.Verifier {
+	return scram.NewVerifier(string(algo.B64RawEncode(password)), salt, scram.Iterations)
+}
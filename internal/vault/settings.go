@@ -26,6 +26,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -34,7 +35,7 @@ const (
 
 // GetIMAPPort sets the port that the IMAP server should listen on.
 func (vault *Vault) GetIMAPPort() int {
-	return vault.getSafe().Settings.IMAPPort
+	return vault.getSettings().IMAPPort
 }
 
 // SetIMAPPort sets the port that the IMAP server should listen on.
@@ -46,7 +47,7 @@ func (vault *Vault) SetIMAPPort(port int) error {
 
 // GetSMTPPort sets the port that the SMTP server should listen on.
 func (vault *Vault) GetSMTPPort() int {
-	return vault.getSafe().Settings.SMTPPort
+	return vault.getSettings().SMTPPort
 }
 
 // SetSMTPPort sets the port that the SMTP server should listen on.
@@ -56,9 +57,57 @@ func (vault *Vault) SetSMTPPort(port int) error {
 	})
 }
 
+// GetIMAPListeners returns the addresses the IMAP server should listen on besides the IMAP port on localhost.
+func (vault *Vault) GetIMAPListeners() []ListenerSpec {
+	return slices.Clone(vault.getSettings().IMAPListeners)
+}
+
+// SetIMAPListeners sets the addresses the IMAP server should listen on besides the IMAP port on localhost.
+func (vault *Vault) SetIMAPListeners(listeners []ListenerSpec) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.IMAPListeners = slices.Clone(listeners)
+	})
+}
+
+// GetSMTPListeners returns the addresses the SMTP server should listen on besides the SMTP port on localhost.
+func (vault *Vault) GetSMTPListeners() []ListenerSpec {
+	return slices.Clone(vault.getSettings().SMTPListeners)
+}
+
+// SetSMTPListeners sets the addresses the SMTP server should listen on besides the SMTP port on localhost.
+func (vault *Vault) SetSMTPListeners(listeners []ListenerSpec) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.SMTPListeners = slices.Clone(listeners)
+	})
+}
+
+// GetUnixSockets returns whether the servers should also listen on Unix sockets.
+func (vault *Vault) GetUnixSockets() bool {
+	return vault.getSettings().UnixSockets
+}
+
+// SetUnixSockets sets whether the servers should also listen on Unix sockets.
+func (vault *Vault) SetUnixSockets(unixSockets bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.UnixSockets = unixSockets
+	})
+}
+
+// GetClientCertMode returns whether IMAP and SMTP clients are asked for a TLS client certificate.
+func (vault *Vault) GetClientCertMode() ClientCertMode {
+	return vault.getSettings().ClientCertMode
+}
+
+// SetClientCertMode sets whether IMAP and SMTP clients are asked for a TLS client certificate.
+func (vault *Vault) SetClientCertMode(mode ClientCertMode) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.ClientCertMode = mode
+	})
+}
+
 // GetIMAPSSL sets whether the IMAP server should use SSL.
 func (vault *Vault) GetIMAPSSL() bool {
-	return vault.getSafe().Settings.IMAPSSL
+	return vault.getSettings().IMAPSSL
 }
 
 // SetIMAPSSL sets whether the IMAP server should use SSL.
@@ -70,7 +119,7 @@ func (vault *Vault) SetIMAPSSL(ssl bool) error {
 
 // GetSMTPSSL sets whether the SMTP server should use SSL.
 func (vault *Vault) GetSMTPSSL() bool {
-	return vault.getSafe().Settings.SMTPSSL
+	return vault.getSettings().SMTPSSL
 }
 
 // SetSMTPSSL sets whether the SMTP server should use SSL.
@@ -82,7 +131,7 @@ func (vault *Vault) SetSMTPSSL(ssl bool) error {
 
 // GetGluonCacheDir sets the directory where the gluon should store its data.
 func (vault *Vault) GetGluonCacheDir() string {
-	return vault.getSafe().Settings.GluonDir
+	return vault.getSettings().GluonDir
 }
 
 // SetGluonDir sets the directory where the gluon should store its data.
@@ -94,7 +143,7 @@ func (vault *Vault) SetGluonDir(dir string) error {
 
 // GetUpdateChannel sets the update channel.
 func (vault *Vault) GetUpdateChannel() updater.Channel {
-	return vault.getSafe().Settings.UpdateChannel
+	return vault.getSettings().UpdateChannel
 }
 
 // SetUpdateChannel sets the update channel.
@@ -107,7 +156,7 @@ func (vault *Vault) SetUpdateChannel(channel updater.Channel) error {
 // GetUpdateRollout sets the update rollout.
 func (vault *Vault) GetUpdateRollout() float64 {
 	// The rollout value 0.6046602879796196 is forbidden. The RNG was not seeded when it was picked (GODT-2319).
-	rollout := vault.getSafe().Settings.UpdateRollout
+	rollout := vault.getSettings().UpdateRollout
 	if math.Abs(rollout-ForbiddenRollout) >= 0.00000001 {
 		return rollout
 	}
@@ -128,7 +177,7 @@ func (vault *Vault) SetUpdateRollout(rollout float64) error {
 
 // GetColorScheme sets the color scheme to be used by the bridge GUI.
 func (vault *Vault) GetColorScheme() string {
-	return vault.getSafe().Settings.ColorScheme
+	return vault.getSettings().ColorScheme
 }
 
 // SetColorScheme sets the color scheme to be used by the bridge GUI.
@@ -140,7 +189,7 @@ func (vault *Vault) SetColorScheme(colorScheme string) error {
 
 // GetProxyAllowed sets whether the bridge is allowed to use alternative routing.
 func (vault *Vault) GetProxyAllowed() bool {
-	return vault.getSafe().Settings.ProxyAllowed
+	return vault.getSettings().ProxyAllowed
 }
 
 // SetProxyAllowed sets whether the bridge is allowed to use alternative routing.
@@ -152,7 +201,7 @@ func (vault *Vault) SetProxyAllowed(allowed bool) error {
 
 // GetShowAllMail sets whether the bridge should show the All Mail folder.
 func (vault *Vault) GetShowAllMail() bool {
-	return vault.getSafe().Settings.ShowAllMail
+	return vault.getSettings().ShowAllMail
 }
 
 // SetShowAllMail sets whether the bridge should show the All Mail folder.
@@ -164,7 +213,7 @@ func (vault *Vault) SetShowAllMail(showAllMail bool) error {
 
 // GetAutostart sets whether the bridge should autostart.
 func (vault *Vault) GetAutostart() bool {
-	return vault.getSafe().Settings.Autostart
+	return vault.getSettings().Autostart
 }
 
 // SetAutostart sets whether the bridge should autostart.
@@ -176,7 +225,7 @@ func (vault *Vault) SetAutostart(autostart bool) error {
 
 // GetAutoUpdate sets whether the bridge should automatically update.
 func (vault *Vault) GetAutoUpdate() bool {
-	return vault.getSafe().Settings.AutoUpdate
+	return vault.getSettings().AutoUpdate
 }
 
 // SetAutoUpdate sets whether the bridge should automatically update.
@@ -188,7 +237,7 @@ func (vault *Vault) SetAutoUpdate(autoUpdate bool) error {
 
 // GetTelemetryDisabled checks whether telemetry is disabled.
 func (vault *Vault) GetTelemetryDisabled() bool {
-	return vault.getSafe().Settings.TelemetryDisabled
+	return vault.getSettings().TelemetryDisabled
 }
 
 // SetTelemetryDisabled sets whether telemetry is disabled.
@@ -200,7 +249,7 @@ func (vault *Vault) SetTelemetryDisabled(telemetryDisabled bool) error {
 
 // GetLastVersion returns the last version of the bridge that was run.
 func (vault *Vault) GetLastVersion() *semver.Version {
-	return semver.MustParse(vault.getSafe().Settings.LastVersion)
+	return semver.MustParse(vault.getSettings().LastVersion)
 }
 
 // SetLastVersion sets the last version of the bridge that was run.
@@ -212,7 +261,7 @@ func (vault *Vault) SetLastVersion(version *semver.Version) error {
 
 // GetFirstStart returns whether this is the first time the bridge has been started.
 func (vault *Vault) GetFirstStart() bool {
-	return vault.getSafe().Settings.FirstStart
+	return vault.getSettings().FirstStart
 }
 
 // SetFirstStart sets whether this is the first time the bridge has been started.
@@ -224,7 +273,7 @@ func (vault *Vault) SetFirstStart(firstStart bool) error {
 
 // GetMaxSyncMemory returns the maximum amount of memory the sync process should use.
 func (vault *Vault) GetMaxSyncMemory() uint64 {
-	v := vault.getSafe().Settings.MaxSyncMemory
+	v := vault.getSettings().MaxSyncMemory
 	// can be zero if never written to vault before.
 	if v == 0 {
 		return DefaultMaxSyncMemory
@@ -242,7 +291,7 @@ func (vault *Vault) SetMaxSyncMemory(maxMemory uint64) error {
 
 // GetLastUserAgent returns the last user agent recorded by bridge.
 func (vault *Vault) GetLastUserAgent() string {
-	v := vault.getSafe().Settings.LastUserAgent
+	v := vault.getSettings().LastUserAgent
 
 	// Handle case where there may be no value.
 	if len(v) == 0 {
@@ -261,7 +310,7 @@ func (vault *Vault) SetLastUserAgent(userAgent string) error {
 
 // GetLastHeartbeatSent returns the last time heartbeat was sent.
 func (vault *Vault) GetLastHeartbeatSent() time.Time {
-	return vault.getSafe().Settings.LastHeartbeatSent
+	return vault.getSettings().LastHeartbeatSent
 }
 
 // SetLastHeartbeatSent store the last time heartbeat was sent.
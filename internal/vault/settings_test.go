@@ -64,6 +64,75 @@ func TestVault_Settings_SMTP(t *testing.T) {
 	require.Equal(t, true, s.GetSMTPSSL())
 }
 
+func TestVault_Settings_Listeners(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// By default, there are no additional listeners.
+	require.Empty(t, s.GetIMAPListeners())
+	require.Empty(t, s.GetSMTPListeners())
+
+	// Modify the listeners.
+	require.NoError(t, s.SetIMAPListeners([]vault.ListenerSpec{{Address: "[::1]:1143"}, {Address: "0.0.0.0:1993", SSL: true}}))
+	require.NoError(t, s.SetSMTPListeners([]vault.ListenerSpec{{Address: "192.168.1.10:1025"}}))
+
+	// Check the new listeners.
+	require.Equal(t, []vault.ListenerSpec{{Address: "[::1]:1143"}, {Address: "0.0.0.0:1993", SSL: true}}, s.GetIMAPListeners())
+	require.Equal(t, []vault.ListenerSpec{{Address: "192.168.1.10:1025"}}, s.GetSMTPListeners())
+}
+
+func TestVault_Settings_UnixSockets(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// By default, the servers don't listen on Unix sockets.
+	require.False(t, s.GetUnixSockets())
+
+	// Listen on Unix sockets.
+	require.NoError(t, s.SetUnixSockets(true))
+
+	// Check the new setting.
+	require.True(t, s.GetUnixSockets())
+}
+
+func TestVault_Settings_ClientCertMode(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// By default, clients aren't asked for a certificate.
+	require.Equal(t, vault.ClientCertsOff, s.GetClientCertMode())
+
+	// Require client certificates.
+	require.NoError(t, s.SetClientCertMode(vault.ClientCertsRequired))
+
+	// Check the new setting.
+	require.Equal(t, vault.ClientCertsRequired, s.GetClientCertMode())
+}
+
+func TestParseListenerSpec(t *testing.T) {
+	for value, want := range map[string]vault.ListenerSpec{
+		"127.0.0.1:1143":       {Address: "127.0.0.1:1143"},
+		"tcp://[::1]:1143":     {Address: "[::1]:1143"},
+		"tls://0.0.0.0:1993":   {Address: "0.0.0.0:1993", SSL: true},
+		"tls://:1465":          {Address: ":1465", SSL: true},
+		"tcp://localhost:1025": {Address: "localhost:1025"},
+	} {
+		spec, err := vault.ParseListenerSpec(value)
+		require.NoError(t, err, value)
+		require.Equal(t, want, spec)
+
+		// The spec can be parsed back from its string form.
+		parsed, err := vault.ParseListenerSpec(spec.String())
+		require.NoError(t, err)
+		require.Equal(t, spec, parsed)
+	}
+
+	for _, value := range []string{"", "1143", "::1:1143", "udp://127.0.0.1:1143", "127.0.0.1:0", "127.0.0.1:65536", "127.0.0.1:imap"} {
+		_, err := vault.ParseListenerSpec(value)
+		require.Error(t, err, value)
+	}
+}
+
 func TestVault_Settings_GluonDir(t *testing.T) {
 	// create a new test vault.
 	s, corrupt, err := vault.New(t.TempDir(), "/path/to/gluon", []byte("my secret key"), async.NoopPanicHandler{})
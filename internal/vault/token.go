@@ -33,3 +33,8 @@ func newRandomToken(size int) []byte {
 
 	return token
 }
+
+// NewGluonKey returns a new random key for a user's gluon database.
+func NewGluonKey() []byte {
+	return newRandomToken(32)
+}
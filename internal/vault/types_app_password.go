@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+)
+
+// AppPassword is a named password which can be used instead of the bridge password
+// to authenticate over IMAP and SMTP, typically one per device.
+// Only a hash of the password and its SCRAM verifier are stored; the password itself is only known when it is created.
+type AppPassword struct {
+	ID    string
+	Name  string
+	Hash  []byte
+	Scope AppPasswordScope
+
+	// Verifier is the SCRAM verifier of the password; app passwords created before SCRAM was supported have none.
+	Verifier scram.Verifier
+
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// AppPasswordScope restricts what an app password can be used for.
+// The zero value grants the same access as the bridge password.
+type AppPasswordScope struct {
+	IMAP IMAPAccess
+	SMTP SMTPAccess
+
+	// Addresses restricts the app password to the given addresses of the user; all of them if empty.
+	// As all addresses are served together in combined mode, restricted app passwords can then only be used over SMTP.
+	Addresses []string
+}
+
+// IMAPAccess is the access an app password grants over IMAP.
+type IMAPAccess int
+
+const (
+	IMAPReadWrite IMAPAccess = iota
+	IMAPReadOnly
+	IMAPDenied
+)
+
+func (access IMAPAccess) String() string {
+	switch access {
+	case IMAPReadWrite:
+		return "read-write"
+
+	case IMAPReadOnly:
+		return "read-only"
+
+	case IMAPDenied:
+		return "none"
+
+	default:
+		return "unknown"
+	}
+}
+
+// SMTPAccess is the access an app password grants over SMTP.
+type SMTPAccess int
+
+const (
+	SMTPSend SMTPAccess = iota
+	SMTPDenied
+)
+
+func (access SMTPAccess) String() string {
+	switch access {
+	case SMTPSend:
+		return "send"
+
+	case SMTPDenied:
+		return "none"
+
+	default:
+		return "unknown"
+	}
+}
+
+// HasAddress returns whether the scope allows using the given address.
+func (scope AppPasswordScope) HasAddress(email string) bool {
+	if len(scope.Addresses) == 0 {
+		return true
+	}
+
+	for _, address := range scope.Addresses {
+		if strings.EqualFold(address, email) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -26,6 +26,16 @@ type Certs struct {
 	// If non-empty, the path to the PEM-encoded certificate file.
 	CustomCertPath string
 	CustomKeyPath  string
+
+	// Hosts are the hostnames and IP addresses the generated certificate is valid for; the default host if empty.
+	Hosts []string
+
+	// KeyType is the type of the private key of the generated certificate.
+	KeyType certs.KeyType
+
+	// ClientCA is the CA issuing the enrolled client certificates, kept apart from the TLS certificate
+	// so that regenerating the latter doesn't revoke them.
+	ClientCA Cert
 }
 
 type Cert struct {
@@ -34,7 +44,8 @@ type Cert struct {
 
 func newDefaultCerts() Certs {
 	return Certs{
-		Bridge: newTLSCert(),
+		Bridge:   newTLSCert(),
+		ClientCA: newClientCA(),
 	}
 }
 
@@ -54,3 +65,20 @@ func newTLSCert() Cert {
 		Key:  keyPEM,
 	}
 }
+
+func newClientCA() Cert {
+	template, err := certs.NewClientCATemplate()
+	if err != nil {
+		panic(err)
+	}
+
+	certPEM, keyPEM, err := certs.GenerateCertWithKeyType(template, certs.KeyTypeECDSA)
+	if err != nil {
+		panic(err)
+	}
+
+	return Cert{
+		Cert: certPEM,
+		Key:  keyPEM,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"strings"
+	"time"
+)
+
+// ClientCert maps a TLS client certificate to a user.
+// A certificate is matched either by its fingerprint, whoever issued it,
+// or by its subject, in which case it must have been issued by the client CA.
+type ClientCert struct {
+	ID   string
+	Name string
+
+	// Fingerprint is the hex-encoded SHA-256 hash of the DER certificate.
+	Fingerprint string
+
+	// Subject is the distinguished name of the certificate, as formatted by pkix.Name.String.
+	Subject string
+
+	// SkipPassword is whether clients presenting the certificate can authenticate without a password (SASL EXTERNAL).
+	// It only applies to SMTP, as IMAP clients can only log in with a password.
+	SkipPassword bool
+
+	CreatedAt time.Time
+}
+
+// Matches returns whether the client certificate mapping matches the given certificate.
+// The fingerprint is the hex-encoded SHA-256 hash of the certificate; trusted is whether it was issued by the client CA.
+func (cert ClientCert) Matches(fingerprint, subject string, trusted bool) bool {
+	if cert.Fingerprint != "" {
+		return strings.EqualFold(cert.Fingerprint, fingerprint)
+	}
+
+	return trusted && cert.Subject != "" && cert.Subject == subject
+}
+
+// ClientCertMode is whether IMAP and SMTP clients are asked for a TLS client certificate.
+type ClientCertMode int
+
+const (
+	// ClientCertsOff doesn't ask clients for a certificate.
+	ClientCertsOff ClientCertMode = iota
+
+	// ClientCertsOptional asks clients for a certificate, which can be used to authenticate without a password.
+	ClientCertsOptional
+
+	// ClientCertsRequired additionally requires clients authenticating with a password over TCP
+	// to present a certificate mapped to the same user.
+	ClientCertsRequired
+)
+
+func (mode ClientCertMode) String() string {
+	switch mode {
+	case ClientCertsOff:
+		return "off"
+
+	case ClientCertsOptional:
+		return "optional"
+
+	case ClientCertsRequired:
+		return "required"
+
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ListenerSchemeTCP is the scheme of listeners on which clients may upgrade to TLS with STARTTLS.
+	ListenerSchemeTCP = "tcp"
+
+	// ListenerSchemeTLS is the scheme of listeners on which clients connect with implicit TLS.
+	ListenerSchemeTLS = "tls"
+)
+
+// ListenerSpec describes an address on which the IMAP or SMTP server listens,
+// in addition to the configured port on localhost.
+type ListenerSpec struct {
+	// Address is the host and port to listen on, e.g. 192.168.1.10:1143 or [::1]:1993.
+	// An empty host listens on all interfaces.
+	Address string
+
+	// SSL is whether clients connect with implicit TLS rather than upgrading with STARTTLS.
+	SSL bool
+}
+
+// ParseListenerSpec parses a listener spec of the form [scheme://]host:port, where the scheme is tcp (the default)
+// for connections which may be upgraded with STARTTLS, or tls for implicit TLS connections.
+func ParseListenerSpec(value string) (ListenerSpec, error) {
+	var spec ListenerSpec
+
+	scheme, address, ok := strings.Cut(value, "://")
+	if !ok {
+		scheme, address = ListenerSchemeTCP, value
+	}
+
+	switch scheme {
+	case ListenerSchemeTCP:
+		spec.SSL = false
+
+	case ListenerSchemeTLS:
+		spec.SSL = true
+
+	default:
+		return ListenerSpec{}, fmt.Errorf("unknown listener scheme %q", scheme)
+	}
+
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return ListenerSpec{}, fmt.Errorf("invalid listener address %q: %w", address, err)
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return ListenerSpec{}, fmt.Errorf("invalid listener port %q", port)
+	}
+
+	spec.Address = address
+
+	return spec, nil
+}
+
+// String returns the listener spec in the form parsed by ParseListenerSpec.
+func (spec ListenerSpec) String() string {
+	if spec.SSL {
+		return ListenerSchemeTLS + "://" + spec.Address
+	}
+
+	return ListenerSchemeTCP + "://" + spec.Address
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import "time"
+
+// OutboxMessage is a message waiting in a user's outbox to be sent.
+type OutboxMessage struct {
+	ID     string
+	AuthID string
+
+	From string
+	To   []string
+
+	// DSN holds the delivery status notification parameters given for the recipients, keyed by address.
+	DSN map[string]OutboxDSN
+
+	// DSNReturnFull and DSNEnvelopeID are the RET and ENVID parameters given for the message.
+	DSNReturnFull bool
+	DSNEnvelopeID string
+
+	Literal []byte
+
+	// DraftID is the ID of the draft created by a previous attempt to send the message, and AttachmentIDs the IDs of
+	// the attachments uploaded with it, if any.
+	DraftID       string
+	AttachmentIDs []string
+
+	// SendReq is the JSON-encoded request made to send the draft, if that is where the previous attempt failed.
+	// The message is then retried by sending the same draft again rather than creating another one.
+	SendReq []byte
+
+	QueuedAt  time.Time
+	Attempts  int
+	LastError string
+}
+
+// OutboxDSN holds the delivery status notification parameters (RFC 3461) given for a recipient of a queued message.
+type OutboxDSN struct {
+	Notify            uint8
+	OriginalRecipient string
+}
@@ -20,6 +20,6 @@ package vault
 // PasswordArchive maps a list email address hashes to passwords.
 // The type is not defined as a map alias to prevent having to handle nil default values when vault was created by an older version of the application.
 type PasswordArchive struct {
-	// we store the SHA-256 sum as string for readability and JSON marshalling of map[[32]byte][]byte will not be allowed, thus breaking vault-editor.
+	// we store the SHA-256 sum as string for readability and JSON marshalling of map[[32]byte][]byte will not be allowed, thus breaking the vault export.
 	Archive map[string][]byte
 }
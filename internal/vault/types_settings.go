@@ -35,6 +35,16 @@ type Settings struct {
 	IMAPSSL  bool
 	SMTPSSL  bool
 
+	// IMAPListeners and SMTPListeners are the addresses the servers listen on besides the ports above on localhost.
+	IMAPListeners []ListenerSpec
+	SMTPListeners []ListenerSpec
+
+	// UnixSockets is whether the IMAP, SMTP and gRPC servers also listen on Unix sockets in the user's runtime dir.
+	UnixSockets bool
+
+	// ClientCertMode is whether IMAP and SMTP clients are asked for a TLS client certificate.
+	ClientCertMode ClientCertMode
+
 	UpdateChannel updater.Channel
 	UpdateRollout float64
 
@@ -88,6 +98,12 @@ func newDefaultSettings(gluonDir string) Settings {
 		IMAPSSL:  false,
 		SMTPSSL:  false,
 
+		IMAPListeners: nil,
+		SMTPListeners: nil,
+		UnixSockets:   false,
+
+		ClientCertMode: ClientCertsOff,
+
 		UpdateChannel: updater.DefaultUpdateChannel,
 		UpdateRollout: rand.Float64(), //nolint:gosec
 
@@ -17,7 +17,10 @@
 
 package vault
 
-import "github.com/ProtonMail/gluon/imap"
+import (
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
+)
 
 // UserData holds information about a single bridge user.
 // The user may or may not be logged in.
@@ -31,6 +34,21 @@ type UserData struct {
 	BridgePass  []byte // raw token represented as byte slice (needs to be encoded)
 	AddressMode AddressMode
 
+	// AppPasswords holds the named passwords which can be used instead of the bridge password.
+	AppPasswords []AppPassword
+
+	// SCRAMSalt is the salt shared by the SCRAM verifiers of the bridge password and the app passwords,
+	// as clients are sent the salt before proving which password they know.
+	SCRAMSalt          []byte
+	BridgePassVerifier scram.Verifier
+
+	// ClientCerts holds the TLS client certificates mapped to the user.
+	ClientCerts []ClientCert
+
+	// PendingGluonKey is the key the gluon database is being re-encrypted with, until it replaces GluonKey.
+	// It is written before the re-encrypted stores replace the current ones, so that they can be recovered.
+	PendingGluonKey []byte
+
 	AuthUID string
 	AuthRef string
 	KeyPass []byte
@@ -79,7 +97,7 @@ func newDefaultUser(userID, username, primaryEmail, authUID, authRef string, key
 		Username:     username,
 		PrimaryEmail: primaryEmail,
 
-		GluonKey:    newRandomToken(32),
+		GluonKey:    NewGluonKey(),
 		GluonIDs:    make(map[string]string),
 		UIDValidity: make(map[string]imap.UID),
 		BridgePass:  bridgePass,
@@ -18,9 +18,11 @@
 package vault
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/bradenaw/juniper/xslices"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -51,11 +53,32 @@ func (user *User) SetPrimaryEmail(email string) error {
 
 // GluonKey returns the key needed to decrypt the user's gluon database.
 func (user *User) GluonKey() []byte {
-	return user.vault.getUser(user.userID).GluonKey
+	return getUserValue(user.vault, user.userID, func(data UserData) []byte { return bytes.Clone(data.GluonKey) })
+}
+
+// PendingGluonKey returns the key the user's gluon database is being re-encrypted with, if it is.
+func (user *User) PendingGluonKey() []byte {
+	return getUserValue(user.vault, user.userID, func(data UserData) []byte { return bytes.Clone(data.PendingGluonKey) })
+}
+
+// SetPendingGluonKey sets the key the user's gluon database is being re-encrypted with, or clears it if nil.
+func (user *User) SetPendingGluonKey(key []byte) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.PendingGluonKey = bytes.Clone(key)
+	})
+}
+
+// PromotePendingGluonKey makes the pending gluon key the user's one, once the gluon database is encrypted with it.
+func (user *User) PromotePendingGluonKey() error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		if data.PendingGluonKey != nil {
+			data.GluonKey, data.PendingGluonKey = data.PendingGluonKey, nil
+		}
+	})
 }
 
 func (user *User) GetGluonIDs() map[string]string {
-	return user.vault.getUser(user.userID).GluonIDs
+	return getUserValue(user.vault, user.userID, func(data UserData) map[string]string { return maps.Clone(data.GluonIDs) })
 }
 
 func (user *User) SetGluonID(addrID, gluonID string) error {
@@ -94,13 +117,17 @@ func (user *User) SetAddressMode(mode AddressMode) error {
 
 // BridgePass returns the user's bridge password as raw token bytes (unencoded).
 func (user *User) BridgePass() []byte {
-	return user.vault.getUser(user.userID).BridgePass
+	return getUserValue(user.vault, user.userID, func(data UserData) []byte { return bytes.Clone(data.BridgePass) })
 }
 
 // SetBridgePass saves bridge password as raw token bytes (unecoded).
 func (user *User) SetBridgePass(newPass []byte) error {
 	return user.vault.modUser(user.userID, func(data *UserData) {
-		data.BridgePass = newPass
+		data.BridgePass = bytes.Clone(newPass)
+
+		if len(data.SCRAMSalt) > 0 {
+			data.BridgePassVerifier = newSCRAMVerifier(newPass, data.SCRAMSalt)
+		}
 	})
 }
 
@@ -126,25 +153,25 @@ func (user *User) setAuthAndKeyPassUnsafe(authUID, authRef string, keyPass []byt
 	return user.vault.modUserUnsafe(user.userID, func(userData *UserData) {
 		userData.AuthRef = authRef
 		userData.AuthUID = authUID
-		userData.KeyPass = keyPass
+		userData.KeyPass = bytes.Clone(keyPass)
 	})
 }
 
 // KeyPass returns the user's (salted) key password.
 func (user *User) KeyPass() []byte {
-	return user.vault.getUser(user.userID).KeyPass
+	return getUserValue(user.vault, user.userID, func(data UserData) []byte { return bytes.Clone(data.KeyPass) })
 }
 
 // SetKeyPass sets the user's (salted) key password.
 func (user *User) SetKeyPass(keyPass []byte) error {
 	return user.vault.modUser(user.userID, func(data *UserData) {
-		data.KeyPass = keyPass
+		data.KeyPass = bytes.Clone(keyPass)
 	})
 }
 
 // SyncStatus return's the user's sync status.
 func (user *User) SyncStatus() SyncStatus {
-	return user.vault.getUser(user.userID).SyncStatus
+	return getUserValue(user.vault, user.userID, func(data UserData) SyncStatus { return data.SyncStatus.clone() })
 }
 
 // SetHasLabels sets whether the user's labels have been synced.
@@ -186,9 +213,16 @@ func (user *User) RemFailedMessageID(messageID string) error {
 	})
 }
 
+// ClearFailedMessageIDs clears the list of failed message IDs.
+func (user *User) ClearFailedMessageIDs() error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.SyncStatus.FailedMessageIDs = nil
+	})
+}
+
 // GetSyncStatus returns the user's sync status.
 func (user *User) GetSyncStatus() SyncStatus {
-	return user.vault.getUser(user.userID).SyncStatus
+	return getUserValue(user.vault, user.userID, func(data UserData) SyncStatus { return data.SyncStatus.clone() })
 }
 
 // ClearSyncStatus clears the user's sync status.
@@ -20,8 +20,11 @@ package vault_test
 import (
 	"runtime"
 	"testing"
+	"time"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/scram"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -143,6 +146,15 @@ func TestUser_SyncStatus(t *testing.T) {
 	require.False(t, user.SyncStatus().HasLabels)
 	require.False(t, user.SyncStatus().HasMessages)
 	require.Empty(t, user.SyncStatus().LastMessageID)
+
+	// Simulate messages failing to sync.
+	require.NoError(t, user.AddFailedMessageID("failed1"))
+	require.NoError(t, user.AddFailedMessageID("failed2"))
+	require.Equal(t, []string{"failed1", "failed2"}, user.SyncStatus().FailedMessageIDs)
+
+	// Clear the failed messages.
+	require.NoError(t, user.ClearFailedMessageIDs())
+	require.Empty(t, user.SyncStatus().FailedMessageIDs)
 }
 
 func TestUser_PrimaryEmail(t *testing.T) {
@@ -214,3 +226,168 @@ func TestUser_ForEach(t *testing.T) {
 	// The store should have no users again.
 	require.Empty(t, s.GetUserIDs())
 }
+
+func TestUser_AppPasswords(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// The user has no app passwords by default.
+	require.Empty(t, user.GetAppPasswords())
+
+	// Create an app password.
+	appPass, password, err := user.AddAppPassword("laptop", vault.AppPasswordScope{})
+	require.NoError(t, err)
+	require.Equal(t, "laptop", appPass.Name)
+	require.NotEmpty(t, appPass.ID)
+
+	// Only its hash is stored.
+	require.Len(t, user.GetAppPasswords(), 1)
+	require.Equal(t, appPass.ID, user.GetAppPasswords()[0].ID)
+	require.Equal(t, appPass.Hash, user.GetAppPasswords()[0].Hash)
+	require.NotEqual(t, password, appPass.Hash)
+
+	// The name must be unique and not empty.
+	_, _, err = user.AddAppPassword("laptop", vault.AppPasswordScope{})
+	require.ErrorIs(t, err, vault.ErrAppPasswordExists)
+
+	_, _, err = user.AddAppPassword("", vault.AppPasswordScope{})
+	require.Error(t, err)
+
+	// The password matches the app password.
+	match, ok := user.CheckAppPassword(password)
+	require.True(t, ok)
+	require.Equal(t, appPass.ID, match.ID)
+
+	_, ok = user.CheckAppPassword([]byte("wrong"))
+	require.False(t, ok)
+
+	// Record the last use of the app password.
+	lastUsed := time.Now()
+	require.NoError(t, user.SetAppPasswordLastUsed(appPass.ID, lastUsed))
+	require.True(t, lastUsed.Equal(user.GetAppPasswords()[0].LastUsedAt))
+
+	// Revoke the app password.
+	require.NoError(t, user.RemoveAppPassword(appPass.ID))
+	require.ErrorIs(t, user.RemoveAppPassword(appPass.ID), vault.ErrNoSuchAppPassword)
+	require.Empty(t, user.GetAppPasswords())
+
+	_, ok = user.CheckAppPassword(password)
+	require.False(t, ok)
+}
+
+func TestUser_SCRAMCredentials(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// The user gets a salt and the verifier of the bridge password on first use.
+	salt, credentials, err := user.GetSCRAMCredentials()
+	require.NoError(t, err)
+	require.NotEmpty(t, salt)
+	require.Len(t, credentials, 1)
+	require.Empty(t, credentials[0].AppPasswordID)
+
+	bridgePass := scram.NewVerifier(string(algo.B64RawEncode(user.BridgePass())), salt, scram.Iterations)
+	require.Equal(t, bridgePass, credentials[0].Verifier)
+
+	// App passwords get a verifier derived with the same salt.
+	appPass, password, err := user.AddAppPassword("laptop", vault.AppPasswordScope{})
+	require.NoError(t, err)
+
+	newSalt, credentials, err := user.GetSCRAMCredentials()
+	require.NoError(t, err)
+	require.Equal(t, salt, newSalt)
+	require.Len(t, credentials, 2)
+	require.Equal(t, appPass.ID, credentials[1].AppPasswordID)
+	require.Equal(t, scram.NewVerifier(string(algo.B64RawEncode(password)), salt, scram.Iterations), credentials[1].Verifier)
+
+	// Changing the bridge password changes its verifier.
+	require.NoError(t, user.SetBridgePass([]byte("new bridge pass")))
+
+	_, credentials, err = user.GetSCRAMCredentials()
+	require.NoError(t, err)
+	require.NotEqual(t, bridgePass, credentials[0].Verifier)
+	require.Equal(t, scram.NewVerifier(string(algo.B64RawEncode([]byte("new bridge pass"))), salt, scram.Iterations), credentials[0].Verifier)
+}
+
+func TestUser_ClientCerts(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// The user has no client certificates by default.
+	require.Empty(t, user.GetClientCerts())
+
+	// Map a certificate by its fingerprint and another by its subject.
+	pinned, err := user.AddClientCert("laptop", "abcdef", "", true)
+	require.NoError(t, err)
+	require.True(t, pinned.SkipPassword)
+
+	issued, err := user.AddClientCert("phone", "", "CN=phone", false)
+	require.NoError(t, err)
+	require.Len(t, user.GetClientCerts(), 2)
+
+	// The name and the fingerprint must be unique, and either the fingerprint or the subject is needed.
+	_, err = user.AddClientCert("laptop", "123456", "", false)
+	require.ErrorIs(t, err, vault.ErrClientCertExists)
+
+	_, err = user.AddClientCert("tablet", "abcdef", "", false)
+	require.ErrorIs(t, err, vault.ErrClientCertExists)
+
+	_, err = user.AddClientCert("tablet", "", "", false)
+	require.Error(t, err)
+
+	// Pinned certificates are matched by fingerprint whoever issued them.
+	require.True(t, pinned.Matches("ABCDEF", "CN=laptop", false))
+	require.False(t, pinned.Matches("123456", "CN=laptop", true))
+
+	// Other certificates are matched by subject only if issued by the bridge.
+	require.True(t, issued.Matches("123456", "CN=phone", true))
+	require.False(t, issued.Matches("123456", "CN=phone", false))
+	require.False(t, issued.Matches("123456", "CN=tablet", true))
+
+	// Remove a certificate.
+	require.NoError(t, user.RemoveClientCert(pinned.ID))
+	require.ErrorIs(t, user.RemoveClientCert(pinned.ID), vault.ErrNoSuchClientCert)
+	require.Len(t, user.GetClientCerts(), 1)
+	require.Equal(t, issued.ID, user.GetClientCerts()[0].ID)
+}
+
+func TestUser_PendingGluonKey(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// There is no pending key by default, so there is none to promote.
+	require.Nil(t, user.PendingGluonKey())
+	require.NoError(t, user.PromotePendingGluonKey())
+	require.Equal(t, "token", string(user.GluonKey()))
+
+	// A pending key doesn't replace the current one until it is promoted.
+	require.NoError(t, user.SetPendingGluonKey([]byte("new key")))
+	require.Equal(t, "new key", string(user.PendingGluonKey()))
+	require.Equal(t, "token", string(user.GluonKey()))
+
+	require.NoError(t, user.PromotePendingGluonKey())
+	require.Nil(t, user.PendingGluonKey())
+	require.Equal(t, "new key", string(user.GluonKey()))
+
+	// A pending key can be cleared.
+	require.NoError(t, user.SetPendingGluonKey([]byte("other key")))
+	require.NoError(t, user.SetPendingGluonKey(nil))
+	require.NoError(t, user.PromotePendingGluonKey())
+	require.Equal(t, "new key", string(user.GluonKey()))
+}
@@ -28,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/bradenaw/juniper/parallel"
@@ -35,17 +36,40 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// vaultFileName is the name of the vault file in the vault directory.
+const vaultFileName = "vault.enc"
+
+// ErrReadOnly is returned when changing a vault opened with Open.
+var ErrReadOnly = errors.New("the vault is opened read-only")
+
+// flushDelay is how long changes to the vault are kept in memory before being written, so that close changes,
+// e.g. while syncing several users, are written at once.
+const flushDelay = time.Second
+
 // Vault is an encrypted data vault that stores bridge and user data.
+// Its data is kept decrypted in memory; changes are written to the encrypted file shortly after, and when it is closed.
 type Vault struct {
 	path string
 	gcm  cipher.AEAD
 
-	enc []byte
+	data Data
+
+	// readOnly is true when the vault was opened with Open; changes to it are then refused.
+	readOnly bool
+
+	// dirty is true when data has changes which aren't written yet; flushTimer then writes them after flushDelay.
+	dirty      bool
+	flushTimer *time.Timer
+
+	// backedUpAt is when the vault file was last backed up, before a write; it is zero until the first write.
+	backedUpAt time.Time
 
 	ref map[string]int
 
 	lock sync.RWMutex
 
+	outboxLock sync.Mutex
+
 	panicHandler async.PanicHandler
 }
 
@@ -55,26 +79,62 @@ func New(vaultDir, gluonCacheDir string, key []byte, panicHandler async.PanicHan
 		return nil, false, err
 	}
 
-	hash256 := sha256.Sum256(key)
-
-	aes, err := aes.NewCipher(hash256[:])
+	gcm, err := newGCM(key)
 	if err != nil {
 		return nil, false, err
 	}
 
-	gcm, err := cipher.NewGCM(aes)
+	vault, corrupt, err := newVault(filepath.Join(vaultDir, vaultFileName), gluonCacheDir, gcm)
 	if err != nil {
 		return nil, false, err
 	}
 
-	vault, corrupt, err := newVault(filepath.Join(vaultDir, "vault.enc"), gluonCacheDir, gcm)
+	vault.panicHandler = panicHandler
+
+	return vault, corrupt, nil
+}
+
+// Open opens the vault in the given directory for reading only, e.g. to inspect it while the bridge is stopped.
+// Unlike New, it neither finishes an interrupted re-encryption nor restores a backup in place of a vault which can't be
+// read, and it doesn't reset a vault of which no generation can be read: it then holds the default data and is reported
+// corrupt. Changes to it fail with ErrReadOnly.
+func Open(vaultDir, gluonCacheDir string, key []byte, panicHandler async.PanicHandler) (*Vault, bool, error) {
+	gcm, err := newGCM(key)
 	if err != nil {
 		return nil, false, err
 	}
 
-	vault.panicHandler = panicHandler
+	path := filepath.Join(vaultDir, vaultFileName)
 
-	return vault, corrupt, nil
+	var (
+		data    Data
+		corrupt bool
+	)
+
+	// A vault re-encrypted with the given key is read in place of the current one, which New would replace with it.
+	if err := readVault(path+rekeySuffix, gcm, &data); err != nil {
+		switch idx, err := readGenerations(path, gcm, &data); {
+		case errors.Is(err, fs.ErrNotExist):
+			data = newDefaultData(gluonCacheDir)
+
+		case err != nil:
+			logrus.WithError(err).Warn("Failed to read the vault and its backups")
+
+			data, corrupt = newDefaultData(gluonCacheDir), true
+
+		case idx > 0:
+			logrus.WithField("generation", idx).Warn("Failed to read the vault, read it from a backup")
+		}
+	}
+
+	return &Vault{
+		path:         path,
+		data:         data,
+		gcm:          gcm,
+		readOnly:     true,
+		ref:          make(map[string]int),
+		panicHandler: panicHandler,
+	}, corrupt, nil
 }
 
 // GetUserIDs returns the user IDs and usernames of all users in the vault.
@@ -82,7 +142,7 @@ func (vault *Vault) GetUserIDs() []string {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
 
-	return xslices.Map(vault.getUnsafe().Users, func(user UserData) string {
+	return xslices.Map(vault.data.Users, func(user UserData) string {
 		return user.UserID
 	})
 }
@@ -91,7 +151,7 @@ func (vault *Vault) getUsers() ([]*User, error) {
 	vault.lock.Lock()
 	defer vault.lock.Unlock()
 
-	users := vault.getUnsafe().Users
+	users := vault.data.Users
 
 	result := make([]*User, 0, len(users))
 
@@ -118,7 +178,7 @@ func (vault *Vault) HasUser(userID string) bool {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
 
-	return xslices.IndexFunc(vault.getUnsafe().Users, func(user UserData) bool {
+	return xslices.IndexFunc(vault.data.Users, func(user UserData) bool {
 		return user.UserID == userID
 	}) >= 0
 }
@@ -145,7 +205,7 @@ func (vault *Vault) NewUser(userID string) (*User, error) {
 }
 
 func (vault *Vault) newUserUnsafe(userID string) (*User, error) {
-	if idx := xslices.IndexFunc(vault.getUnsafe().Users, func(user UserData) bool {
+	if idx := xslices.IndexFunc(vault.data.Users, func(user UserData) bool {
 		return user.UserID == userID
 	}); idx < 0 {
 		return nil, errors.New("no such user")
@@ -223,7 +283,7 @@ func (vault *Vault) GetOrAddUser(userID, username, primaryEmail, authUID, authRe
 	defer vault.lock.Unlock()
 
 	{
-		users := vault.getUnsafe().Users
+		users := vault.data.Users
 
 		idx := xslices.IndexFunc(users, func(user UserData) bool {
 			return user.UserID == userID
@@ -256,7 +316,7 @@ func (vault *Vault) DeleteUser(userID string) error {
 		return fmt.Errorf("user %s is currently in use", userID)
 	}
 
-	return vault.modUnsafe(func(data *Data) {
+	if err := vault.modUnsafe(func(data *Data) {
 		idx := xslices.IndexFunc(data.Users, func(user UserData) bool {
 			return user.UserID == userID
 		})
@@ -266,14 +326,18 @@ func (vault *Vault) DeleteUser(userID string) error {
 		}
 		data.Settings.PasswordArchive.set(data.Users[idx].PrimaryEmail, data.Users[idx].BridgePass)
 		data.Users = append(data.Users[:idx], data.Users[idx+1:]...)
-	})
+	}); err != nil {
+		return err
+	}
+
+	return vault.removeOutbox(userID)
 }
 
 func (vault *Vault) Migrated() bool {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
 
-	return vault.getUnsafe().Migrated
+	return vault.data.Migrated
 }
 
 func (vault *Vault) SetMigrated() error {
@@ -298,10 +362,24 @@ func (vault *Vault) Path() string {
 	return vault.path
 }
 
+// Flush writes the pending changes to the vault, if any, to its file.
+func (vault *Vault) Flush() error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	return vault.flushUnsafe()
+}
+
+// Close writes the pending changes to the vault. It can no longer be used afterwards.
 func (vault *Vault) Close() error {
 	vault.lock.Lock()
 	defer vault.lock.Unlock()
 
+	// Write the changes even if the vault is still in use, as it is closed when exiting.
+	if err := vault.flushUnsafe(); err != nil {
+		return err
+	}
+
 	if len(vault.ref) > 0 {
 		return errors.New("vault is still in use")
 	}
@@ -342,55 +420,64 @@ func (vault *Vault) detachUser(userID string) error {
 }
 
 func newVault(path, gluonDir string, gcm cipher.AEAD) (*Vault, bool, error) {
-	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
-		if _, err := initVault(path, gluonDir, gcm); err != nil {
-			return nil, false, err
-		}
+	var (
+		data    Data
+		corrupt bool
+	)
+
+	// If the vault was being re-encrypted with a new key, finish or undo it.
+	if err := completeRekey(path, gcm); err != nil {
+		return nil, false, fmt.Errorf("failed to complete vault re-encryption: %w", err)
 	}
 
-	enc, err := os.ReadFile(filepath.Clean(path))
-	if err != nil {
-		return nil, false, err
-	}
+	// If the vault can't be read, e.g. after a bad write, fall back to the most recent backup which can.
+	idx, err := readGenerations(path, gcm, &data)
 
-	var corrupt bool
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		data = newDefaultData(gluonDir)
+
+	case err != nil:
+		logrus.WithError(err).Error("Failed to read the vault and its backups, moving them aside and resetting the vault")
+
+		if err := moveGenerationsAside(path); err != nil {
+			return nil, false, fmt.Errorf("failed to move the corrupt vault aside: %w", err)
+		}
 
-	if err := unmarshalFile(gcm, enc, new(Data)); err != nil {
-		corrupt = true
+		data, corrupt = newDefaultData(gluonDir), true
+
+	case idx > 0:
+		logrus.WithField("generation", idx).Warn("Failed to read the vault, restored it from a backup")
 	}
 
-	if corrupt {
-		newEnc, err := initVault(path, gluonDir, gcm)
-		if err != nil {
+	if err != nil || idx > 0 {
+		if err := writeVault(path, gcm, data); err != nil {
 			return nil, false, err
 		}
-
-		enc = newEnc
 	}
 
 	return &Vault{
 		path: path,
-		enc:  enc,
+		data: data,
 		gcm:  gcm,
 		ref:  make(map[string]int),
 	}, corrupt, nil
 }
 
-func (vault *Vault) getSafe() Data {
+// getSettings returns a copy of the settings. Slices and maps are shared with the vault; getters returning them copy them.
+func (vault *Vault) getSettings() Settings {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
 
-	return vault.getUnsafe()
+	return vault.data.Settings
 }
 
-func (vault *Vault) getUnsafe() Data {
-	var data Data
-
-	if err := unmarshalFile(vault.gcm, vault.enc, &data); err != nil {
-		panic(err)
-	}
+// getCerts returns a copy of the certificates. Slices are shared with the vault; getters returning them copy them.
+func (vault *Vault) getCerts() Certs {
+	vault.lock.RLock()
+	defer vault.lock.RUnlock()
 
-	return data
+	return vault.data.Certs
 }
 
 func (vault *Vault) modSafe(fn func(data *Data)) error {
@@ -400,32 +487,75 @@ func (vault *Vault) modSafe(fn func(data *Data)) error {
 	return vault.modUnsafe(fn)
 }
 
+// modUnsafe changes the vault data in memory and schedules writing it to the file, unless it already is.
 func (vault *Vault) modUnsafe(fn func(data *Data)) error {
-	var data Data
+	if vault.gcm == nil {
+		return errors.New("vault is closed")
+	}
 
-	if err := unmarshalFile(vault.gcm, vault.enc, &data); err != nil {
-		return err
+	if vault.readOnly {
+		return ErrReadOnly
 	}
 
-	fn(&data)
+	fn(&vault.data)
 
-	enc, err := marshalFile(vault.gcm, data)
-	if err != nil {
+	vault.dirty = true
+
+	if vault.flushTimer == nil {
+		vault.flushTimer = time.AfterFunc(flushDelay, func() {
+			defer async.HandlePanic(vault.panicHandler)
+
+			if err := vault.Flush(); err != nil {
+				logrus.WithError(err).Error("Failed to write vault")
+			}
+		})
+	}
+
+	return nil
+}
+
+// flushUnsafe writes the vault data to the file if it has pending changes.
+// If it fails, the changes are written again with the next ones.
+func (vault *Vault) flushUnsafe() error {
+	if vault.flushTimer != nil {
+		vault.flushTimer.Stop()
+		vault.flushTimer = nil
+	}
+
+	if !vault.dirty {
+		return nil
+	}
+
+	if time.Since(vault.backedUpAt) >= backupInterval {
+		if err := backupVault(vault.path, vault.gcm); err != nil {
+			logrus.WithError(err).Warn("Failed to back up vault")
+		} else {
+			vault.backedUpAt = time.Now()
+		}
+	}
+
+	if err := writeVault(vault.path, vault.gcm, vault.data); err != nil {
 		return err
 	}
 
-	vault.enc = enc
+	vault.dirty = false
 
-	return os.WriteFile(vault.path, vault.enc, 0o600)
+	return nil
 }
 
+// getUser returns a copy of the user's data. Its slices and maps are shared with the vault, which may change them
+// once the lock is released: they must be read with getUserValue instead.
 func (vault *Vault) getUser(userID string) UserData {
+	return getUserValue(vault, userID, func(user UserData) UserData { return user })
+}
+
+// getUserValue returns what fn reads from the user's data while holding the lock.
+// Slices and maps are shared with the vault, so fn must copy those it returns.
+func getUserValue[T any](vault *Vault, userID string, fn func(UserData) T) T {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
 
-	users := vault.getUnsafe().Users
-
-	idx := xslices.IndexFunc(users, func(user UserData) bool {
+	idx := xslices.IndexFunc(vault.data.Users, func(user UserData) bool {
 		return user.UserID == userID
 	})
 
@@ -433,7 +563,7 @@ func (vault *Vault) getUser(userID string) UserData {
 		panic("Unknown user")
 	}
 
-	return users[idx]
+	return fn(vault.data.Users[idx])
 }
 
 func (vault *Vault) modUser(userID string, fn func(userData *UserData)) error {
@@ -453,15 +583,59 @@ func (vault *Vault) modUserUnsafe(userID string, fn func(userData *UserData)) er
 	})
 }
 
-func initVault(path, gluonDir string, gcm cipher.AEAD) ([]byte, error) {
-	enc, err := marshalFile(gcm, newDefaultData(gluonDir))
+// writeVault encrypts the given data and writes it to the given path.
+// The file is replaced atomically, so that it is never left half-written, e.g. after a crash.
+func writeVault(path string, gcm cipher.AEAD, data Data) error {
+	enc, err := marshalFile(gcm, data)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := os.WriteFile(path, enc, 0o600); err != nil {
+	return writeFileAtomic(path, enc)
+}
+
+// writeFileAtomic replaces the given file with the given content, syncing it before it takes the place of the old one.
+func writeFileAtomic(path string, b []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	if _, err := file.Write(b); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return err
+	}
+
+	// Also sync the directory so that the rename is persisted; not all platforms support it.
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		_ = dir.Sync()
+		_ = dir.Close()
+	}
+
+	return nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	hash256 := sha256.Sum256(key)
+
+	aes, err := aes.NewCipher(hash256[:])
+	if err != nil {
 		return nil, err
 	}
 
-	return enc, nil
+	return cipher.NewGCM(aes)
 }
@@ -19,6 +19,7 @@ package vault_test
 
 import (
 	"bytes"
+	"fmt"
 	"runtime"
 	"testing"
 
@@ -29,6 +30,81 @@ import (
 )
 
 func BenchmarkVault(b *testing.B) {
+	s := newBenchVault(b)
+
+	b.ResetTimer()
+
+	// Time how quickly we can iterate through the users and get their key pass and bridge pass.
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, s.ForUser(runtime.NumCPU(), func(user *vault.User) error {
+			require.NotEmpty(b, user.KeyPass())
+			require.NotEmpty(b, user.BridgePass())
+			return nil
+		}))
+	}
+}
+
+func BenchmarkVault_Get(b *testing.B) {
+	s := newBenchVault(b)
+
+	b.ResetTimer()
+
+	// Time how quickly we can read the settings read when serving a connection.
+	for i := 0; i < b.N; i++ {
+		require.NotZero(b, s.GetIMAPPort())
+		require.NotZero(b, s.GetSMTPPort())
+		require.Empty(b, s.GetIMAPListeners())
+		require.True(b, s.GetShowAllMail())
+	}
+}
+
+func BenchmarkVault_Auth(b *testing.B) {
+	s := newBenchVault(b)
+
+	b.ResetTimer()
+
+	// Time how quickly we can check each user's bridge password and app passwords, as done on every login.
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, s.ForUser(runtime.NumCPU(), func(user *vault.User) error {
+			_, found := user.CheckAppPassword(user.BridgePass())
+			require.False(b, found)
+			return nil
+		}))
+	}
+}
+
+func BenchmarkVault_Set(b *testing.B) {
+	s := newBenchVault(b)
+
+	b.ResetTimer()
+
+	// Time how quickly we can record the last event of each user, as done on every event poll.
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, s.ForUser(runtime.NumCPU(), func(user *vault.User) error {
+			return user.SetEventID(fmt.Sprintf("event-%d", i))
+		}))
+	}
+}
+
+func BenchmarkVault_SetAndFlush(b *testing.B) {
+	s := newBenchVault(b)
+
+	b.ResetTimer()
+
+	// Time how quickly we can record the last event of each user and write the vault, as done when closing it.
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, s.ForUser(runtime.NumCPU(), func(user *vault.User) error {
+			return user.SetEventID(fmt.Sprintf("event-%d", i))
+		}))
+
+		require.NoError(b, s.Flush())
+	}
+}
+
+// newBenchVault creates a new vault with 10 users with 3 app passwords each and 10kB of cookies.
+func newBenchVault(b *testing.B) *vault.Vault {
+	b.Helper()
+
 	vaultDir, gluonDir := b.TempDir(), b.TempDir()
 
 	// Create a new vault.
@@ -36,6 +112,8 @@ func BenchmarkVault(b *testing.B) {
 	require.NoError(b, err)
 	require.False(b, corrupt)
 
+	b.Cleanup(func() { require.NoError(b, s.Close()) })
+
 	// Add 10kB of cookies to the vault.
 	require.NoError(b, s.SetCookies(bytes.Repeat([]byte("a"), 10_000)))
 
@@ -45,16 +123,14 @@ func BenchmarkVault(b *testing.B) {
 		require.NoError(b, err)
 
 		require.NoError(b, user.SetKeyPass([]byte("new key pass")))
-	}
 
-	b.ResetTimer()
+		for appPass := 0; appPass < 3; appPass++ {
+			_, _, err := user.AddAppPassword(fmt.Sprintf("device-%d", appPass), vault.AppPasswordScope{})
+			require.NoError(b, err)
+		}
 
-	// Time how quickly we can iterate through the users and get their key pass and bridge pass.
-	for i := 0; i < b.N; i++ {
-		require.NoError(b, s.ForUser(runtime.NumCPU(), func(user *vault.User) error {
-			require.NotEmpty(b, user.KeyPass())
-			require.NotEmpty(b, user.BridgePass())
-			return nil
-		}))
+		require.NoError(b, user.Close())
 	}
+
+	return s
 }
@@ -18,13 +18,17 @@
 package vault_test
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	dockerCredentials "github.com/docker/docker-credential-helpers/credentials"
 	"github.com/stretchr/testify/require"
 )
 
@@ -79,6 +83,57 @@ func TestVault_Corrupt_JunkData(t *testing.T) {
 	}
 }
 
+func TestVault_Open(t *testing.T) {
+	vaultDir, gluonDir := t.TempDir(), t.TempDir()
+
+	// A vault which doesn't exist isn't created.
+	{
+		s, corrupt, err := vault.Open(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.False(t, corrupt)
+		require.NoError(t, s.Close())
+		require.NoFileExists(t, filepath.Join(vaultDir, "vault.enc"))
+	}
+
+	{
+		s, _, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.NoError(t, s.SetIMAPPort(1234))
+		require.NoError(t, s.Close())
+	}
+
+	enc, err := os.ReadFile(filepath.Join(vaultDir, "vault.enc"))
+	require.NoError(t, err)
+
+	// The vault can be read but not changed.
+	{
+		s, corrupt, err := vault.Open(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.False(t, corrupt)
+		require.Equal(t, 1234, s.GetIMAPPort())
+		require.ErrorIs(t, s.SetIMAPPort(5678), vault.ErrReadOnly)
+		require.ErrorIs(t, s.Rekey([]byte("new key"), func([]byte) error { return nil }), vault.ErrReadOnly)
+		require.NoError(t, s.Close())
+	}
+
+	// A vault which can't be read is reported corrupt, but it is neither reset nor moved aside.
+	{
+		s, corrupt, err := vault.Open(vaultDir, gluonDir, []byte("bad key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.True(t, corrupt)
+		require.Empty(t, s.GetUserIDs())
+		require.NoError(t, s.Close())
+	}
+
+	after, err := os.ReadFile(filepath.Join(vaultDir, "vault.enc"))
+	require.NoError(t, err)
+	require.Equal(t, enc, after)
+
+	corruptFiles, err := filepath.Glob(filepath.Join(vaultDir, "*.corrupt-*"))
+	require.NoError(t, err)
+	require.Empty(t, corruptFiles)
+}
+
 func TestVault_Reset(t *testing.T) {
 	s := newVault(t)
 
@@ -98,6 +153,301 @@ func TestVault_Reset(t *testing.T) {
 	require.Equal(t, ports.FindFreePortFrom(1025), s.GetSMTPPort())
 }
 
+func TestVault_Flush(t *testing.T) {
+	vaultDir, gluonDir := t.TempDir(), t.TempDir()
+
+	s, corrupt, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+
+	// Changes are written shortly after they are made.
+	require.NoError(t, s.SetIMAPPort(1234))
+	require.NoError(t, s.SetSMTPPort(5678))
+
+	require.Eventually(t, func() bool {
+		s, _, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+
+		return s.GetIMAPPort() == 1234 && s.GetSMTPPort() == 5678
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Pending changes are written when the vault is closed.
+	require.NoError(t, s.SetIMAPPort(4321))
+	require.NoError(t, s.Close())
+	require.Error(t, s.SetIMAPPort(1111))
+
+	s, corrupt, err = vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 4321, s.GetIMAPPort())
+
+	// The file is replaced atomically, without leaving temporary files behind.
+	tmpFiles, err := filepath.Glob(filepath.Join(vaultDir, "*.tmp"))
+	require.NoError(t, err)
+	require.Empty(t, tmpFiles)
+}
+
+func TestVault_Backups(t *testing.T) {
+	vaultDir, gluonDir := t.TempDir(), t.TempDir()
+
+	// Write a few generations of the vault.
+	for port := 1001; port <= 1005; port++ {
+		s, _, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.NoError(t, s.SetIMAPPort(port))
+		require.NoError(t, s.Close())
+	}
+
+	// The current generation and the three previous ones are kept.
+	generations, err := vault.ListGenerations(vaultDir, []byte("my secret key"))
+	require.NoError(t, err)
+	require.Len(t, generations, 4)
+
+	for idx, generation := range generations {
+		require.Equal(t, idx, generation.Index)
+		require.NoError(t, generation.Err)
+		require.Zero(t, generation.UserCount)
+	}
+
+	// Corrupt the current generation; the previous one is used instead, and the vault isn't reset.
+	require.NoError(t, os.WriteFile(filepath.Join(vaultDir, "vault.enc"), []byte("junk data"), 0o600))
+
+	generations, err = vault.ListGenerations(vaultDir, []byte("my secret key"))
+	require.NoError(t, err)
+	require.Error(t, generations[0].Err)
+
+	s, corrupt, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1004, s.GetIMAPPort())
+	require.NoError(t, s.Close())
+
+	// An older generation can be restored; the current one is then kept as a backup.
+	require.NoError(t, vault.RestoreGeneration(vaultDir, []byte("my secret key"), 3))
+	require.ErrorIs(t, vault.RestoreGeneration(vaultDir, []byte("my secret key"), 4), vault.ErrNoSuchGeneration)
+
+	s, corrupt, err = vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1002, s.GetIMAPPort())
+	require.NoError(t, s.Close())
+
+	require.NoError(t, vault.RestoreGeneration(vaultDir, []byte("my secret key"), 1))
+
+	s, corrupt, err = vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1004, s.GetIMAPPort())
+	require.NoError(t, s.Close())
+
+	// If no generation can be read, e.g. with the wrong key, the vault is reset, and the generations are moved aside.
+	s, corrupt, err = vault.New(vaultDir, gluonDir, []byte("bad key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.True(t, corrupt)
+	require.NoError(t, s.Close())
+
+	corruptFiles, err := filepath.Glob(filepath.Join(vaultDir, "vault.enc*.corrupt-*"))
+	require.NoError(t, err)
+	require.Len(t, corruptFiles, 4)
+
+	// They can still be read with the right key.
+	corruptFiles, err = filepath.Glob(filepath.Join(vaultDir, "vault.enc.corrupt-*"))
+	require.NoError(t, err)
+	require.Len(t, corruptFiles, 1)
+	require.NoError(t, os.Rename(corruptFiles[0], filepath.Join(vaultDir, "vault.enc")))
+
+	s, corrupt, err = vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1004, s.GetIMAPPort())
+	require.NoError(t, s.Close())
+}
+
+func TestVault_Backups_Rotation(t *testing.T) {
+	vaultDir, gluonDir := t.TempDir(), t.TempDir()
+
+	for port := 1001; port <= 1005; port++ {
+		s, _, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.NoError(t, s.SetIMAPPort(port))
+		require.NoError(t, s.Close())
+	}
+
+	// The vault is backed up once while it is open, not with every write.
+	s, _, err := vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, s.SetIMAPPort(2001))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.SetIMAPPort(2002))
+	require.NoError(t, s.Close())
+
+	// A backup which can't be read is dropped before the older ones.
+	require.NoError(t, os.WriteFile(filepath.Join(vaultDir, "vault.enc.2"), []byte("junk data"), 0o600))
+
+	s, _, err = vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, s.SetIMAPPort(3001))
+	require.NoError(t, s.Close())
+
+	generations, err := vault.ListGenerations(vaultDir, []byte("my secret key"))
+	require.NoError(t, err)
+	require.Len(t, generations, 4)
+
+	for _, generation := range generations {
+		require.NoError(t, generation.Err)
+	}
+
+	require.NoError(t, vault.RestoreGeneration(vaultDir, []byte("my secret key"), 3))
+
+	s, _, err = vault.New(vaultDir, gluonDir, []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.Equal(t, 1003, s.GetIMAPPort())
+	require.NoError(t, s.Close())
+
+	// A vault file which can't be read is not backed up.
+	require.NoError(t, os.WriteFile(filepath.Join(vaultDir, "vault.enc"), []byte("junk data"), 0o600))
+	require.NoError(t, vault.RestoreGeneration(vaultDir, []byte("my secret key"), 2))
+
+	generations, err = vault.ListGenerations(vaultDir, []byte("my secret key"))
+	require.NoError(t, err)
+
+	for _, generation := range generations {
+		require.NoError(t, generation.Err)
+	}
+}
+
+func TestVault_Rekey(t *testing.T) {
+	vaultDir, gluonDir := t.TempDir(), t.TempDir()
+
+	keychainHelper := keychain.NewTestHelper()
+
+	keychain.Helpers["mock"] = func(string) (dockerCredentials.Helper, error) { return keychainHelper, nil }
+
+	kc, err := keychain.NewKeychain("mock", "bridge")
+	require.NoError(t, err)
+
+	oldKey, err := vault.NewVaultKey(kc)
+	require.NoError(t, err)
+
+	// Write a few generations of the vault.
+	for port := 1001; port <= 1003; port++ {
+		s, _, err := vault.New(vaultDir, gluonDir, oldKey, async.NoopPanicHandler{})
+		require.NoError(t, err)
+		require.NoError(t, s.SetIMAPPort(port))
+		require.NoError(t, s.Close())
+	}
+
+	// Re-encrypt the vault with a new key, which replaces the old one in the keychain.
+	s, _, err := vault.New(vaultDir, gluonDir, oldKey, async.NoopPanicHandler{})
+	require.NoError(t, err)
+
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.PutOutboxMessage(vault.OutboxMessage{ID: "queued", Literal: []byte("queued message")}))
+
+	require.NoError(t, vault.RekeyVault(kc, s))
+
+	// The outbox is re-encrypted too.
+	requireOutboxMessage(t, s, "userID", "queued")
+	require.NoError(t, user.Close())
+	require.NoError(t, s.Close())
+
+	newKey, err := vault.GetVaultKey(kc)
+	require.NoError(t, err)
+	require.NotEqual(t, oldKey, newKey)
+
+	// The vault and its backups can only be read with the new key.
+	generations, err := vault.ListGenerations(vaultDir, oldKey)
+	require.NoError(t, err)
+	require.Len(t, generations, 4)
+
+	for _, generation := range generations {
+		require.Error(t, generation.Err)
+	}
+
+	generations, err = vault.ListGenerations(vaultDir, newKey)
+	require.NoError(t, err)
+	require.Len(t, generations, 4)
+
+	for _, generation := range generations {
+		require.NoError(t, generation.Err)
+	}
+
+	s, corrupt, err := vault.New(vaultDir, gluonDir, newKey, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1003, s.GetIMAPPort())
+	requireOutboxMessage(t, s, "userID", "queued")
+
+	// If the key can't be stored, the vault is still read with the current one.
+	require.Error(t, s.Rekey([]byte("other key"), func([]byte) error { return errors.New("failed") }))
+	require.NoError(t, s.SetIMAPPort(1004))
+	require.NoError(t, s.Close())
+
+	s, corrupt, err = vault.New(vaultDir, gluonDir, newKey, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1004, s.GetIMAPPort())
+	require.NoFileExists(t, filepath.Join(vaultDir, "vault.enc.rekey"))
+	require.NoFileExists(t, filepath.Join(vaultDir, "outbox", "userID", "queued.rekey"))
+	requireOutboxMessage(t, s, "userID", "queued")
+
+	// If it was stored nonetheless, the vault re-encrypted with it is used.
+	require.Error(t, s.Rekey([]byte("other key"), func([]byte) error { return errors.New("failed") }))
+	require.NoError(t, s.Close())
+
+	s, corrupt, err = vault.New(vaultDir, gluonDir, []byte("other key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.False(t, corrupt)
+	require.Equal(t, 1004, s.GetIMAPPort())
+	requireOutboxMessage(t, s, "userID", "queued")
+	require.NoError(t, s.Close())
+}
+
+func requireOutboxMessage(t *testing.T, s *vault.Vault, userID, messageID string) {
+	require.NoError(t, s.GetUser(userID, func(user *vault.User) {
+		message, err := user.GetOutboxMessage(messageID)
+		require.NoError(t, err)
+		require.Equal(t, messageID+" message", string(message.Literal))
+	}))
+}
+
+func TestVault_Copies(t *testing.T) {
+	s := newVault(t)
+
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, user.Close()) }()
+
+	require.NoError(t, user.SetGluonID("addrID", "gluonID"))
+	require.NoError(t, user.AddFailedMessageID("messageID"))
+	require.NoError(t, s.SetIMAPListeners([]vault.ListenerSpec{{Address: "[::1]:1143"}}))
+
+	_, _, err = user.AddAppPassword("laptop", vault.AppPasswordScope{Addresses: []string{"username@pm.me"}})
+	require.NoError(t, err)
+
+	// Changing the values returned by the vault doesn't change the vault.
+	user.KeyPass()[0] = 'x'
+	user.BridgePass()[0] = 'x'
+	user.GetGluonIDs()["addrID"] = "otherID"
+	user.GetSyncStatus().FailedMessageIDs[0] = "otherID"
+	user.GetAppPasswords()[0].Scope.Addresses[0] = "other@pm.me"
+	user.GetAppPasswords()[0].Hash[0] = 'x'
+	s.GetIMAPListeners()[0].Address = "0.0.0.0:1143"
+
+	require.Equal(t, []byte("keyPass"), user.KeyPass())
+	require.Equal(t, map[string]string{"addrID": "gluonID"}, user.GetGluonIDs())
+	require.Equal(t, []string{"messageID"}, user.GetSyncStatus().FailedMessageIDs)
+	require.Equal(t, []string{"username@pm.me"}, user.GetAppPasswords()[0].Scope.Addresses)
+	require.Equal(t, []vault.ListenerSpec{{Address: "[::1]:1143"}}, s.GetIMAPListeners())
+
+	// Neither does changing the values given to the vault.
+	keyPass := []byte("newKeyPass")
+	require.NoError(t, user.SetKeyPass(keyPass))
+	keyPass[0] = 'x'
+	require.Equal(t, []byte("newKeyPass"), user.KeyPass())
+}
+
 func newVault(t *testing.T) *vault.Vault {
 	t.Helper()
 
@@ -105,5 +455,8 @@ func newVault(t *testing.T) *vault.Vault {
 	require.NoError(t, err)
 	require.False(t, corrupt)
 
+	// Write the pending changes before the vault directory is removed.
+	t.Cleanup(func() { require.NoError(t, s.Flush()) })
+
 	return s
 }
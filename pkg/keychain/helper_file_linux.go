@@ -0,0 +1,438 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package keychain
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// EncryptedFile is the name of the helper storing secrets in a file encrypted with a passphrase,
+	// for systems without a keychain such as servers and containers.
+	EncryptedFile = "encrypted-file"
+
+	// EnvKeychainFile is the environment variable giving the path of the encrypted keychain file.
+	EnvKeychainFile = "BRIDGE_KEYCHAIN_FILE"
+
+	// EnvKeychainPassphrase is the environment variable giving the passphrase of the encrypted keychain file.
+	EnvKeychainPassphrase = "BRIDGE_KEYCHAIN_PASSPHRASE"
+
+	// EnvKeychainPassphraseFD is the environment variable giving a file descriptor from which the passphrase is read.
+	EnvKeychainPassphraseFD = "BRIDGE_KEYCHAIN_PASSPHRASE_FD"
+
+	// KeychainPassphraseCredential is the name of the systemd credential holding the passphrase,
+	// read from the directory given by $CREDENTIALS_DIRECTORY.
+	KeychainPassphraseCredential = "bridge-keychain-passphrase"
+)
+
+const (
+	fileVersion = 1
+
+	// The Argon2id parameters recommended by RFC 9106 when memory is constrained.
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+)
+
+var errNoPassphrase = errors.New("no passphrase for the encrypted keychain file")
+
+// fdPassphrase holds the passphrase read from the file descriptor, which can only be read once.
+var fdPassphrase = struct { //nolint:gochecknoglobals
+	once sync.Once
+	pass []byte
+	err  error
+}{}
+
+// envPassphrase holds the passphrase read from the environment, which is unset once read
+// so that it isn't inherited by the processes bridge starts.
+var envPassphrase = struct { //nolint:gochecknoglobals
+	lock sync.Mutex
+	pass []byte
+}{}
+
+// encryptedFile is the content of the encrypted keychain file.
+type encryptedFile struct {
+	Version int `json:"version"`
+
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+
+	Nonce []byte `json:"nonce"`
+	Data  []byte `json:"data"`
+}
+
+type fileSecret struct {
+	Username string `json:"username"`
+	Secret   string `json:"secret"`
+}
+
+// fileHelper stores secrets in a file encrypted with a key derived from a passphrase with Argon2id.
+type fileHelper struct {
+	path       string
+	passphrase []byte
+	lock       sync.Mutex
+
+	// kdf holds the parameters with which the key was derived from the passphrase;
+	// it is only derived again when they change, as it is slow on purpose.
+	kdf encryptedFile
+	key []byte
+}
+
+// newFileHelper creates the helper once it is selected. It checks that the file, if it exists already,
+// can be decrypted with the passphrase, rather than failing when the first secret is read.
+func newFileHelper(string) (credentials.Helper, error) {
+	path, err := getKeychainFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase, err := getKeychainPassphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	helper := &fileHelper{
+		path:       path,
+		passphrase: passphrase,
+	}
+
+	if _, err := helper.read(); err != nil {
+		return nil, err
+	}
+
+	return helper, nil
+}
+
+func (h *fileHelper) Add(creds *credentials.Credentials) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	secrets, err := h.read()
+	if err != nil {
+		return err
+	}
+
+	secrets[creds.ServerURL] = fileSecret{Username: creds.Username, Secret: creds.Secret}
+
+	return h.write(secrets)
+}
+
+func (h *fileHelper) Delete(serverURL string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	secrets, err := h.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := secrets[serverURL]; !ok {
+		return credentials.NewErrCredentialsNotFound()
+	}
+
+	delete(secrets, serverURL)
+
+	return h.write(secrets)
+}
+
+func (h *fileHelper) Get(serverURL string) (string, string, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	secrets, err := h.read()
+	if err != nil {
+		return "", "", err
+	}
+
+	secret, ok := secrets[serverURL]
+	if !ok {
+		return "", "", credentials.NewErrCredentialsNotFound()
+	}
+
+	return secret.Username, secret.Secret, nil
+}
+
+func (h *fileHelper) List() (map[string]string, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	secrets, err := h.read()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(map[string]string, len(secrets))
+
+	for url, secret := range secrets {
+		list[url] = secret.Username
+	}
+
+	return list, nil
+}
+
+// read decrypts the secrets in the file; there are none if it doesn't exist yet.
+func (h *fileHelper) read() (map[string]fileSecret, error) {
+	b, err := os.ReadFile(filepath.Clean(h.path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(map[string]fileSecret), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var file encryptedFile
+
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse keychain file: %w", err)
+	}
+
+	if file.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported keychain file version %v", file.Version)
+	}
+
+	h.deriveKey(encryptedFile{Salt: file.Salt, Time: file.Time, Memory: file.Memory, Threads: file.Threads})
+
+	gcm, err := newFileGCM(h.key)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := gcm.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt keychain file: wrong passphrase or corrupt file")
+	}
+
+	secrets := make(map[string]fileSecret)
+
+	if err := json.Unmarshal(dec, &secrets); err != nil {
+		return nil, fmt.Errorf("failed to parse keychain file: %w", err)
+	}
+
+	return secrets, nil
+}
+
+// write encrypts the secrets to the file, which is replaced atomically.
+func (h *fileHelper) write(secrets map[string]fileSecret) error {
+	if h.key == nil {
+		salt := make([]byte, argon2SaltLen)
+
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+
+		h.deriveKey(encryptedFile{Salt: salt, Time: argon2Time, Memory: argon2Memory, Threads: argon2Threads})
+	}
+
+	dec, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newFileGCM(h.key)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(encryptedFile{
+		Version: fileVersion,
+		Salt:    h.kdf.Salt,
+		Time:    h.kdf.Time,
+		Memory:  h.kdf.Memory,
+		Threads: h.kdf.Threads,
+		Nonce:   nonce,
+		Data:    gcm.Seal(nil, nonce, dec, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(h.path), filepath.Base(h.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), h.path)
+}
+
+// deriveKey derives the key from the passphrase with the given parameters, unless it already was.
+func (h *fileHelper) deriveKey(kdf encryptedFile) {
+	if h.key != nil && bytes.Equal(kdf.Salt, h.kdf.Salt) && kdf.Time == h.kdf.Time && kdf.Memory == h.kdf.Memory && kdf.Threads == h.kdf.Threads {
+		return
+	}
+
+	h.kdf, h.key = kdf, argon2.IDKey(h.passphrase, kdf.Salt, kdf.Time, kdf.Memory, kdf.Threads, argon2KeyLen)
+}
+
+func newFileGCM(key []byte) (cipher.AEAD, error) {
+	aes, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(aes)
+}
+
+// getKeychainFilePath returns the path of the encrypted keychain file, given by $BRIDGE_KEYCHAIN_FILE
+// or in the user's config directory.
+func getKeychainFilePath() (string, error) {
+	if path := os.Getenv(EnvKeychainFile); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "protonmail", "keychain.enc"), nil
+}
+
+// hasKeychainPassphrase returns whether the passphrase of the encrypted keychain file is given,
+// without reading it.
+func hasKeychainPassphrase() bool {
+	if os.Getenv(EnvKeychainPassphrase) != "" || os.Getenv(EnvKeychainPassphraseFD) != "" {
+		return true
+	}
+
+	if dir := os.Getenv("CREDENTIALS_DIRECTORY"); dir != "" {
+		if _, err := os.Stat(filepath.Join(dir, KeychainPassphraseCredential)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getKeychainPassphrase returns the passphrase of the encrypted keychain file from the environment,
+// a file descriptor or a systemd credential, in that order.
+func getKeychainPassphrase() ([]byte, error) {
+	if pass := readPassphraseEnv(); pass != nil {
+		return pass, nil
+	}
+
+	if fd := os.Getenv(EnvKeychainPassphraseFD); fd != "" {
+		fdPassphrase.once.Do(func() {
+			fdPassphrase.pass, fdPassphrase.err = readPassphraseFD(fd)
+		})
+
+		return fdPassphrase.pass, fdPassphrase.err
+	}
+
+	if dir := os.Getenv("CREDENTIALS_DIRECTORY"); dir != "" {
+		b, err := os.ReadFile(filepath.Join(dir, KeychainPassphraseCredential)) //nolint:gosec
+		if err == nil {
+			return trimPassphrase(b)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read systemd credential: %w", err)
+		}
+	}
+
+	return nil, errNoPassphrase
+}
+
+// readPassphraseEnv returns the passphrase given by the environment, which is unset once read.
+func readPassphraseEnv() []byte {
+	envPassphrase.lock.Lock()
+	defer envPassphrase.lock.Unlock()
+
+	if pass := os.Getenv(EnvKeychainPassphrase); pass != "" {
+		envPassphrase.pass = []byte(pass)
+
+		if err := os.Unsetenv(EnvKeychainPassphrase); err != nil {
+			logrus.WithError(err).Warn("Failed to unset the keychain passphrase from the environment")
+		}
+	}
+
+	return bytes.Clone(envPassphrase.pass)
+}
+
+func readPassphraseFD(fd string) ([]byte, error) {
+	n, err := strconv.ParseUint(fd, 10, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptor %q: %w", fd, err)
+	}
+
+	file := os.NewFile(uintptr(n), "passphrase")
+	if file == nil {
+		return nil, fmt.Errorf("invalid file descriptor %q", fd)
+	}
+	defer func() { _ = file.Close() }()
+
+	b, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read passphrase from file descriptor %v: %w", n, err)
+	}
+
+	return trimPassphrase(b)
+}
+
+// trimPassphrase removes the trailing newline of a passphrase read from a file.
+func trimPassphrase(b []byte) ([]byte, error) {
+	pass := strings.TrimRight(string(b), "\r\n")
+
+	if pass == "" {
+		return nil, errNoPassphrase
+	}
+
+	return []byte(pass), nil
+}
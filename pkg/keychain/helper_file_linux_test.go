@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package keychain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileHelper(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keychain.enc")
+
+	keychain := newKeychain(&fileHelper{path: path, passphrase: []byte("passphrase")}, hostURL("bridge"))
+
+	for id, secret := range testData {
+		require.NoError(t, keychain.Put(id, secret))
+	}
+
+	// The secrets are encrypted.
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	for _, secret := range testData {
+		require.NotContains(t, string(b), secret)
+	}
+
+	// They can be read again with the passphrase.
+	keychain = newKeychain(&fileHelper{path: path, passphrase: []byte("passphrase")}, hostURL("bridge"))
+
+	list, err := keychain.List()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"user1", "user2"}, list)
+
+	for id, secret := range testData {
+		_, actual, err := keychain.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, secret, actual)
+	}
+
+	require.NoError(t, keychain.Delete("user1"))
+
+	list, err = keychain.List()
+	require.NoError(t, err)
+	require.Equal(t, []string{"user2"}, list)
+
+	// They can't be read with another passphrase.
+	keychain = newKeychain(&fileHelper{path: path, passphrase: []byte("other passphrase")}, hostURL("bridge"))
+
+	_, err = keychain.List()
+	require.Error(t, err)
+}
+
+func TestFileHelper_Passphrase(t *testing.T) {
+	t.Setenv(EnvKeychainPassphrase, "")
+	t.Setenv(EnvKeychainPassphraseFD, "")
+	t.Setenv("CREDENTIALS_DIRECTORY", "")
+
+	require.False(t, hasKeychainPassphrase())
+
+	_, err := getKeychainPassphrase()
+	require.ErrorIs(t, err, errNoPassphrase)
+
+	// From a systemd credential.
+	credsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(credsDir, KeychainPassphraseCredential), []byte("from credential\n"), 0o600))
+	t.Setenv("CREDENTIALS_DIRECTORY", credsDir)
+	require.True(t, hasKeychainPassphrase())
+
+	pass, err := getKeychainPassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "from credential", string(pass))
+
+	// From a file descriptor, which takes precedence.
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString("from fd\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	fd, err := syscall.Dup(int(r.Fd()))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	t.Setenv(EnvKeychainPassphraseFD, fmt.Sprint(fd))
+
+	pass, err = getKeychainPassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "from fd", string(pass))
+
+	// It is only read once.
+	pass, err = getKeychainPassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "from fd", string(pass))
+
+	// From the environment, which takes precedence.
+	t.Setenv(EnvKeychainPassphrase, "from env")
+
+	pass, err = getKeychainPassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "from env", string(pass))
+
+	// It is unset once read, so that child processes don't inherit it, but still used.
+	_, ok := os.LookupEnv(EnvKeychainPassphrase)
+	require.False(t, ok)
+
+	pass, err = getKeychainPassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "from env", string(pass))
+}
+
+func TestFileHelper_WrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keychain.enc")
+	t.Setenv(EnvKeychainFile, path)
+
+	// Create the file with a passphrase.
+	require.NoError(t, (&fileHelper{path: path, passphrase: []byte("passphrase")}).Add(&credentials.Credentials{
+		ServerURL: "bridge/check",
+		Username:  "check",
+		Secret:    "check",
+	}))
+
+	// The helper can then only be created with that passphrase.
+	t.Setenv(EnvKeychainPassphrase, "other passphrase")
+
+	_, err := newFileHelper("")
+	require.Error(t, err)
+
+	t.Setenv(EnvKeychainPassphrase, "passphrase")
+
+	_, err = newFileHelper("")
+	require.NoError(t, err)
+}
@@ -48,14 +48,24 @@ func init() { //nolint:gochecknoinits
 		Helpers[Pass] = newPassHelper
 	}
 
+	// The encrypted file is only available if its passphrase is given; it is checked once the helper is selected.
+	if hasKeychainPassphrase() {
+		Helpers[EncryptedFile] = newFileHelper
+	}
+
 	DefaultHelper = SecretServiceDBus
 
 	// If Pass is available, use it by default.
 	// Otherwise, if SecretService is available, use it by default.
+	// Otherwise, if there is no keychain but the encrypted file is available, use it by default.
 	if _, ok := Helpers[Pass]; ok {
 		DefaultHelper = Pass
 	} else if _, ok := Helpers[SecretService]; ok {
 		DefaultHelper = SecretService
+	} else if _, ok := Helpers[SecretServiceDBus]; !ok {
+		if _, ok := Helpers[EncryptedFile]; ok {
+			DefaultHelper = EncryptedFile
+		}
 	}
 }
 
@@ -24,7 +24,9 @@ import (
 	"mime"
 	"net/mail"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ProtonMail/gluon/rfc5322"
 	"github.com/ProtonMail/gluon/rfc822"
@@ -37,6 +39,18 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// Headers which clients set to use Proton sending features. They are not part of the sent message.
+const (
+	// ExpiresInHeader sets the time after which the message expires, in seconds or as a duration such as 24h.
+	ExpiresInHeader = "X-Pm-Expires-In"
+
+	// EOPasswordHeader sets the password protecting the message for external recipients.
+	EOPasswordHeader = "X-Pm-Eo-Password"
+
+	// EOHintHeader sets the hint given to external recipients to find the password.
+	EOHintHeader = "X-Pm-Eo-Hint"
+)
+
 type MIMEBody string
 
 type Body string
@@ -59,6 +73,13 @@ type Message struct {
 	References []string
 	ExternalID string
 	InReplyTo  string
+
+	// ExpiresIn is the time after which the message expires, if it is non-zero.
+	ExpiresIn time.Duration
+
+	// Password protects the message for external recipients, if it is set; PasswordHint helps them find it.
+	Password     string
+	PasswordHint string
 }
 
 type Attachment struct {
@@ -121,6 +142,11 @@ func parse(p *parser.Parser, allowInvalidAddressLists bool) (Message, error) {
 		return Message{}, errors.Wrap(err, "failed to parse message header")
 	}
 
+	// The sending options are not sent; the password in particular must never be sent in the clear.
+	for _, key := range []string{ExpiresInHeader, EOPasswordHeader, EOHintHeader} {
+		p.Root().Header.Del(key)
+	}
+
 	atts, err := collectAttachments(p)
 	if err != nil {
 		return Message{}, errors.Wrap(err, "failed to collect attachments")
@@ -531,12 +557,48 @@ func parseMessageHeader(h message.Header, allowInvalidAddressLists bool) (Messag
 					m.References = append(m.References, strings.Trim(ref, "<>"))
 				}
 			}
+
+		case strings.ToLower(ExpiresInHeader):
+			expiresIn, err := parseExpiresIn(fields.Value())
+			if err != nil {
+				return Message{}, errors.Wrap(err, "failed to parse expiration")
+			}
+
+			m.ExpiresIn = expiresIn
+
+		case strings.ToLower(EOPasswordHeader):
+			m.Password = fields.Value()
+
+		case strings.ToLower(EOHintHeader):
+			hint, err := fields.Text()
+			if err != nil {
+				return Message{}, errors.Wrap(err, "failed to parse password hint")
+			}
+
+			m.PasswordHint = hint
 		}
 	}
 
 	return m, nil
 }
 
+// parseExpiresIn parses the time after which a message expires, given in seconds or as a duration such as 24h.
+func parseExpiresIn(value string) (time.Duration, error) {
+	var expiresIn time.Duration
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		expiresIn = time.Duration(seconds) * time.Second
+	} else if expiresIn, err = time.ParseDuration(value); err != nil {
+		return 0, err
+	}
+
+	if expiresIn <= 0 {
+		return 0, fmt.Errorf("expiration must be positive: %v", value)
+	}
+
+	return expiresIn, nil
+}
+
 func parseAttachment(h message.Header, body []byte) (Attachment, error) {
 	att := Attachment{
 		Data: body,
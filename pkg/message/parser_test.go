@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
@@ -663,6 +664,46 @@ Subject: Test
 	assert.Empty(t, m.ReplyTos)
 }
 
+func TestParseSendOptions(t *testing.T) {
+	const literal = "From: Sender <sender@pm.me>\r\n" +
+		"To: Receiver <receiver@pm.me>\r\n" +
+		"Subject: Test\r\n" +
+		"X-Pm-Expires-In: 3600\r\n" +
+		"X-Pm-Eo-Password: secret\r\n" +
+		"X-Pm-Eo-Hint: =?utf-8?q?the_usual?=\r\n" +
+		"Content-Type: text/plain\r\n" +
+		"\r\n" +
+		"body\r\n"
+
+	m, err := Parse(strings.NewReader(literal))
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Hour, m.ExpiresIn)
+	assert.Equal(t, "secret", m.Password)
+	assert.Equal(t, "the usual", m.PasswordHint)
+
+	// The options are not part of the sent message.
+	assert.NotContains(t, string(m.MIMEBody), "X-Pm-")
+	assert.NotContains(t, string(m.MIMEBody), "secret")
+}
+
+func TestParseExpiresIn(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"60":  time.Minute,
+		"24h": 24 * time.Hour,
+		"90m": 90 * time.Minute,
+	} {
+		expiresIn, err := parseExpiresIn(value)
+		require.NoError(t, err)
+		assert.Equal(t, want, expiresIn)
+	}
+
+	for _, value := range []string{"", "0", "-1", "-1h", "tomorrow"} {
+		_, err := parseExpiresIn(value)
+		assert.Error(t, err, value)
+	}
+}
+
 func TestParsePanic(t *testing.T) {
 	var err error
 
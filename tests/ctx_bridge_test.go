@@ -191,6 +191,11 @@ func (t *testCtx) closeBridge(ctx context.Context) error {
 
 	t.bridge = nil
 
+	// Write the vault, which is read again when the bridge is restarted.
+	if err := t.vault.Flush(); err != nil {
+		return fmt.Errorf("could not write vault: %w", err)
+	}
+
 	return nil
 }
 
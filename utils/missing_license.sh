@@ -22,6 +22,7 @@ MISSING_FILES=$(find . \
     -not -path "./extern/*" \
     -not -path "./*deploy/*" \
     -not -path "./vendor/*" \
+    -not -path "./third_party/*" \
     -not -path "./vendor-cache/*" \
     -not -path "./.cache/*" \
     -not -name "*mock*.go" \
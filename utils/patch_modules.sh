@@ -0,0 +1,60 @@
+#!/bin/bash
+
+# Copyright (c) 2023 Proton AG
+#
+# This file is part of Proton Mail Bridge.
+#
+# Proton Mail Bridge is free software: you can redistribute it and/or modify
+# it under the terms of the GNU General Public License as published by
+# the Free Software Foundation, either version 3 of the License, or
+# (at your option) any later version.
+#
+# Proton Mail Bridge is distributed in the hope that it will be useful,
+# but WITHOUT ANY WARRANTY; without even the implied warranty of
+# MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+# GNU General Public License for more details.
+#
+# You should have received a copy of the GNU General Public License
+# along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+## Apply the patches in third_party to the modules they patch, until the patches are released upstream.
+##
+## For each third_party/<name>.patch, the module replaced by ./third_party/<name> in go.mod is copied from the module
+## cache, at the version required in go.mod, to third_party/<name> and the patch is applied to it. The copy is only
+## made again when the patch or the version changes.
+
+set -eo pipefail
+
+cd "$(dirname "$0")/.."
+
+for patch in third_party/*.patch; do
+    name=$(basename "$patch" .patch)
+    dir=third_party/$name
+
+    module=$(grep -E "=> ./$dir\$" go.mod | awk '{print $1}')
+    if [ -z "$module" ]; then
+        echo "$patch: no module is replaced by ./$dir in go.mod" >&2
+        exit 1
+    fi
+
+    version=$(go mod edit -json | grep -A1 -E "\"Path\": \"$module\"" | sed -n -E 's/.*"Version": "(.*)".*/\1/p' | head -n1)
+    stamp="$module@$version $(sha256sum "$patch" | cut -d' ' -f1)"
+
+    if [ -f "$dir/.patched" ] && [ "$(cat "$dir/.patched")" = "$stamp" ]; then
+        continue
+    fi
+
+    src=$(go mod download -json "$module@$version" | sed -n -E 's/^\t"Dir": "(.*)",?$/\1/p')
+    if [ -z "$src" ]; then
+        echo "$patch: could not download $module@$version" >&2
+        exit 1
+    fi
+
+    echo "Patching $module@$version"
+
+    rm -rf "$dir"
+    cp -r "$src" "$dir"
+    chmod -R u+w "$dir"
+    patch -d "$dir" -p1 --quiet --no-backup-if-mismatch < "$patch"
+    echo "$stamp" > "$dir/.patched"
+done